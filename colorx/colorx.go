@@ -0,0 +1,270 @@
+// Package colorx converts colors between hex, RGB, HSL, CIELAB, OKLab and
+// OKLCH, measures their contrast and difference, builds color harmonies and
+// simulates color vision deficiencies.
+//
+// Hex strings are accepted with or without a leading "#" in the 3 and 6
+// digit forms, and in the 4 and 8 digit forms with alpha where noted. They
+// are returned as uppercase #RRGGBB, or #RRGGBBAA for translucent colors.
+// Parse accepts any CSS color.
+package colorx
+
+import (
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+// Color is an 8-bit sRGB color with an alpha channel, where A = 255 is fully
+// opaque.
+type Color = color.Color
+
+// WCAGRating lists the WCAG 2.x success criteria a contrast ratio passes.
+type WCAGRating = color.WCAGRating
+
+// WCAG 2.x minimum contrast ratios. Large text is at least 18pt, or 14pt
+// bold.
+const (
+	ContrastAALarge  = color.ContrastAALarge
+	ContrastAA       = color.ContrastAA
+	ContrastAAALarge = color.ContrastAAALarge
+	ContrastAAA      = color.ContrastAAA
+)
+
+// Deficiency is a color vision deficiency that colors can be simulated
+// under.
+type Deficiency = color.Deficiency
+
+const (
+	Protanopia    = color.Protanopia
+	Deuteranopia  = color.Deuteranopia
+	Tritanopia    = color.Tritanopia
+	Achromatopsia = color.Achromatopsia
+)
+
+// Harmony is a color scheme built by rotating a color's OKLCH hue.
+type Harmony = color.Harmony
+
+const (
+	Complementary      = color.Complementary
+	Analogous          = color.Analogous
+	Triadic            = color.Triadic
+	Tetradic           = color.Tetradic
+	SplitComplementary = color.SplitComplementary
+)
+
+// ParseError reports a color string that could not be parsed, with the byte
+// offset and text of the offending token.
+type ParseError = color.ParseError
+
+var (
+	// ErrorInvalidHexFormat is returned for hex strings that are not 3 or 6
+	// hex digits long.
+	ErrorInvalidHexFormat = color.ErrorInvalidHexFormat
+	// ErrorInvalidHexAlphaFormat is returned by HexToRGBA for hex strings that
+	// are not 3, 4, 6 or 8 hex digits long.
+	ErrorInvalidHexAlphaFormat = color.ErrorInvalidHexAlphaFormat
+	// ErrorInvalidHSLValues is returned for HSL values outside their range.
+	ErrorInvalidHSLValues = color.ErrorInvalidHSLValues
+	// ErrorInvalidOKLCHValues is returned for OKLab or OKLCH values outside
+	// their range.
+	ErrorInvalidOKLCHValues = color.ErrorInvalidOKLCHValues
+	// ErrorInvalidLabValues is returned for CIELAB lightness outside [0, 100].
+	ErrorInvalidLabValues = color.ErrorInvalidLabValues
+	// ErrorInvalidColor is matched by every error Parse returns.
+	ErrorInvalidColor = color.ErrorInvalidColor
+	// ErrorUnknownDeficiency is returned for unknown color vision
+	// deficiencies.
+	ErrorUnknownDeficiency = color.ErrorUnknownDeficiency
+	// ErrorUnknownHarmony is returned for unknown color harmonies.
+	ErrorUnknownHarmony = color.ErrorUnknownHarmony
+)
+
+// Parse parses any CSS Color Level 4 color: hex, named colors, rgb(), hsl(),
+// hwb(), lab(), lch(), oklab(), oklch() and color(), in both the legacy
+// comma-separated and the modern space-separated syntax. Colors outside the
+// sRGB gamut are clipped. Errors are *ParseError values.
+func Parse(s string) (Color, error) {
+	return color.Parse(s)
+}
+
+// ParseHex parses a hex color such as "#3B82F6", "3b82f6", "#38F" or, with
+// alpha, "#3B82F680".
+func ParseHex(hex string) (Color, error) {
+	return color.ParseHex(hex)
+}
+
+// FromHSL builds a color from hue in degrees [0, 360) and saturation and
+// lightness in [0, 1].
+func FromHSL(h, s, l float64) (Color, error) {
+	return color.FromHSL(h, s, l)
+}
+
+// FromOKLCH builds a color from OKLCH lightness in [0, 1], chroma >= 0 and
+// hue in degrees [0, 360). Colors outside the sRGB gamut are clipped.
+func FromOKLCH(l, c, h float64) (Color, error) {
+	return color.FromOKLCH(l, c, h)
+}
+
+// HexToRGB returns the 8-bit red, green and blue channels of a hex color.
+func HexToRGB(hex string) (r, g, b uint8, err error) {
+	return color.HexToRGB(hex)
+}
+
+// HexToRGBA returns the 8-bit channels of a hex color in the 3, 4, 6 or 8
+// digit form. Colors without alpha are opaque (a = 255).
+func HexToRGBA(hex string) (r, g, b, a uint8, err error) {
+	return color.HexToRGBA(hex)
+}
+
+// RGBToHex formats 8-bit channels as an uppercase #RRGGBB string.
+func RGBToHex(r, g, b uint8) (string, error) {
+	return color.RGBToHex(r, g, b)
+}
+
+// HexToHSL returns hue in degrees and saturation and lightness in [0, 1],
+// rounded to two decimals.
+func HexToHSL(hex string) (h, s, l float64, err error) {
+	return color.HexToHSL(hex)
+}
+
+// HSLToHex converts HSL to an uppercase #RRGGBB string.
+func HSLToHex(h, s, l float64) (string, error) {
+	return color.HSLToHex(h, s, l)
+}
+
+// HexToOKLab returns the OKLab coordinates of a hex color.
+func HexToOKLab(hex string) (l, a, b float64, err error) {
+	return color.HexToOKLab(hex)
+}
+
+// OKLabToHex converts OKLab to an uppercase #RRGGBB string, clipping colors
+// outside the sRGB gamut.
+func OKLabToHex(l, a, b float64) (string, error) {
+	return color.OKLabToHex(l, a, b)
+}
+
+// HexToOKLCH returns OKLCH lightness in [0, 1], chroma and hue in degrees.
+// Achromatic colors report a hue of 0.
+func HexToOKLCH(hex string) (l, c, h float64, err error) {
+	return color.HexToOKLCH(hex)
+}
+
+// OKLCHToHex converts OKLCH to an uppercase #RRGGBB string, clipping colors
+// outside the sRGB gamut.
+func OKLCHToHex(l, c, h float64) (string, error) {
+	return color.OKLCHToHex(l, c, h)
+}
+
+// MaxChroma returns the largest chroma at OKLCH lightness l and hue h that
+// still fits in the sRGB gamut.
+func MaxChroma(l, h float64) float64 {
+	return color.MaxChroma(l, h)
+}
+
+// RelativeLuminance returns the WCAG relative luminance of a hex color in
+// [0, 1]. Alpha is ignored.
+func RelativeLuminance(hex string) (float64, error) {
+	return color.RelativeLuminance(hex)
+}
+
+// ContrastRatio returns the WCAG 2.x contrast ratio between two hex colors,
+// from 1 to 21. The order of the colors does not matter.
+func ContrastRatio(hex1, hex2 string) (float64, error) {
+	return color.ContrastRatio(hex1, hex2)
+}
+
+// HexToLab converts a hex color to CIELAB relative to D50, as used by CSS
+// lab().
+func HexToLab(hex string) (l, a, b float64, err error) {
+	return color.HexToLab(hex)
+}
+
+// LabToHex converts a D50 CIELAB color to hex, clipping colors outside the
+// sRGB gamut.
+func LabToHex(l, a, b float64) (string, error) {
+	return color.LabToHex(l, a, b)
+}
+
+// DeltaE76 returns the CIE76 color difference between two hex colors.
+func DeltaE76(hex1, hex2 string) (float64, error) {
+	return color.DeltaE76(hex1, hex2)
+}
+
+// DeltaE94 returns the CIE94 color difference between two hex colors, with
+// the first as the reference.
+func DeltaE94(hex1, hex2 string) (float64, error) {
+	return color.DeltaE94(hex1, hex2)
+}
+
+// DeltaE2000 returns the CIEDE2000 color difference between two hex colors.
+// A difference of about 1 is just noticeable.
+func DeltaE2000(hex1, hex2 string) (float64, error) {
+	return color.DeltaE2000(hex1, hex2)
+}
+
+// RateContrast rates a contrast ratio against the WCAG 2.x thresholds.
+func RateContrast(ratio float64) WCAGRating {
+	return color.RateContrast(ratio)
+}
+
+// APCAContrast returns the APCA (WCAG 3 draft) lightness contrast Lc of text
+// in one hex color on a background in another. Lc is positive for dark text
+// on light backgrounds and negative for light text on dark backgrounds.
+func APCAContrast(textHex, backgroundHex string) (float64, error) {
+	return color.APCAContrast(textHex, backgroundHex)
+}
+
+// ParseDeficiency parses a deficiency name such as "deuteranopia", or an
+// abbreviation such as "deutan".
+func ParseDeficiency(s string) (Deficiency, error) {
+	return color.ParseDeficiency(s)
+}
+
+// SimulateCVD returns a hex color as it appears under a color vision
+// deficiency, using the Machado et al. (2009) dichromacy matrices, or
+// luminance only for achromatopsia. Alpha is kept.
+func SimulateCVD(hex string, d Deficiency) (string, error) {
+	return color.SimulateCVD(hex, d)
+}
+
+// ParseHarmony parses a harmony name such as "triadic", or the "complement"
+// and "split" abbreviations.
+func ParseHarmony(s string) (Harmony, error) {
+	return color.ParseHarmony(s)
+}
+
+// HarmonyColors returns the colors that form a harmony with a hex color, not
+// including the color itself. Hues are rotated in OKLCH, keeping the
+// perceived lightness and chroma, which is reduced where the rotated hue
+// would leave the sRGB gamut. Alpha is kept.
+func HarmonyColors(hex string, h Harmony) ([]string, error) {
+	return color.HarmonyColors(hex, h)
+}
+
+// Complement returns the complementary color of a hex color, opposite in
+// OKLCH hue.
+func Complement(hex string) (string, error) {
+	return color.Complement(hex)
+}
+
+// AnalogousColors returns the colors 30° to either side of a hex color in
+// OKLCH hue.
+func AnalogousColors(hex string) ([]string, error) {
+	return color.AnalogousColors(hex)
+}
+
+// TriadicColors returns the colors 120° and 240° from a hex color in OKLCH
+// hue.
+func TriadicColors(hex string) ([]string, error) {
+	return color.TriadicColors(hex)
+}
+
+// TetradicColors returns the colors 60°, 180° and 240° from a hex color in
+// OKLCH hue, completing a rectangle on the hue wheel.
+func TetradicColors(hex string) ([]string, error) {
+	return color.TetradicColors(hex)
+}
+
+// SplitComplementaryColors returns the colors 150° and 210° from a hex color
+// in OKLCH hue, either side of its complement.
+func SplitComplementaryColors(hex string) ([]string, error) {
+	return color.SplitComplementaryColors(hex)
+}
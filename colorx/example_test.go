@@ -0,0 +1,39 @@
+package colorx_test
+
+import (
+	"fmt"
+
+	"github.com/claytonchew/tailwindcss-palette-go/colorx"
+)
+
+func ExampleParseHex() {
+	c, err := colorx.ParseHex("#3b82f6")
+	if err != nil {
+		panic(err)
+	}
+
+	l, ch, h := c.OKLCH()
+	fmt.Println(c.Hex(), c.R, c.G, c.B)
+	fmt.Printf("oklch(%.1f%% %.3f %.1f)\n", l*100, ch, h)
+	// Output:
+	// #3B82F6 59 130 246
+	// oklch(62.3% 0.188 259.8)
+}
+
+func ExampleParse() {
+	for _, s := range []string{"rgb(59 130 246)", "hsl(217deg, 91%, 60%)", "cornflowerblue"} {
+		c, err := colorx.Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(c.Hex())
+	}
+
+	_, err := colorx.Parse("rgb(59 130 x)")
+	fmt.Println(err)
+	// Output:
+	// #3B82F6
+	// #3C83F6
+	// #6495ED
+	// invalid color "rgb(59 130 x)" at offset 11: expected a number, percentage or "none", got "x"
+}
@@ -5,11 +5,17 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
 	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
 	"github.com/claytonchew/tailwindcss-palette-go/internal/version"
 )
 
@@ -23,32 +29,182 @@ const (
 type ColorFormat string
 
 const (
-	HexFormat ColorFormat = "hex"
-	HSLFormat ColorFormat = "hsl"
-	RGBFormat ColorFormat = "rgb"
+	HexFormat   ColorFormat = "hex"
+	HSLFormat   ColorFormat = "hsl"
+	RGBFormat   ColorFormat = "rgb"
+	OKLCHFormat ColorFormat = "oklch"
+)
+
+type ExportFormat string
+
+const (
+	JSONExport         ExportFormat = "json"
+	CSSExport          ExportFormat = "css"
+	CommonJSExport     ExportFormat = "js"
+	ESMExport          ExportFormat = "esm"
+	TypeScriptExport   ExportFormat = "ts"
+	DTCGExport         ExportFormat = "dtcg"
+	TokensStudioExport ExportFormat = "tokens-studio"
 )
 
 const (
 	colorReset = "\033[0m"
 	colorBlock = "    "
+	sampleText = " Aa "
 )
 
 var (
 	ErrorInvalidHexInput = errors.New("invalid hex color: must be in format #RRGGBB or #RGB")
-	ErrorInvalidFormat   = errors.New("invalid color format: must be one of 'hex', 'hsl', or 'rgb'")
+	ErrorInvalidFormat   = errors.New("invalid color format: must be one of 'hex', 'hsl', 'rgb', or 'oklch'")
+	ErrorInvalidExport   = errors.New("invalid export format: must be one of 'json', 'css', 'js', 'esm', 'ts', 'dtcg', or 'tokens-studio'")
+	ErrorInvalidName     = errors.New("invalid color name: must start with a letter and contain only letters, digits, and hyphens")
+	ErrorShadesConflict  = errors.New("--shades and --shades-file cannot be used together")
+	ErrorBaseConflict    = errors.New("--base cannot be used together with color arguments")
+	ErrorProfileConflict = errors.New("a Tailwind profile cannot be used together with custom shades")
 )
 
+var colorNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// namedPalette is a generated palette together with the name it is
+// exported under.
+type namedPalette struct {
+	name    string
+	palette generator.Palette
+}
+
+// generationSettings are the per-color generation settings shared by the
+// command line flags and theme config files.
+type generationSettings struct {
+	Mode        string   `json:"mode"`
+	Shades      string   `json:"shades"`
+	Anchor      string   `json:"anchor"`
+	HueShift    *float64 `json:"hueShift"`
+	HueShiftDir string   `json:"hueShiftDir"`
+	Foreground  string   `json:"foreground"`
+	Tailwind    string   `json:"tailwind"`
+	Profile     string   `json:"profile"`
+	Tint        *float64 `json:"tint"`
+}
+
+// generationFlags are the command line flags that control how palettes are
+// generated, shared by the commands that generate palettes.
+type generationFlags struct {
+	mode        *string
+	anchor      *string
+	shades      *string
+	shadesFile  *string
+	hueShift    *float64
+	hueShiftDir *string
+	foreground  *string
+	tailwind    *string
+	profile     *string
+	tint        *float64
+}
+
+func addGenerationFlags(flagSet *flag.FlagSet) generationFlags {
+	return generationFlags{
+		mode:        flagSet.String("mode", string(generator.ModeHSL), "Generation mode: hsl, oklch (perceptual lightness) or neutral (grays tinted with the base hue)"),
+		anchor:      flagSet.String("anchor", "", "Reproduce the base color exactly at this shade, or 'auto' for the best fit"),
+		shades:      flagSet.String("shades", "", "Custom shade scale, e.g. 25:99,50:97,...,950:20 (name:lightness[:saturation[:hue-shift]])"),
+		shadesFile:  flagSet.String("shades-file", "", "Path to a file with a custom shade scale, one name:lightness[:saturation[:hue-shift]] per line"),
+		hueShift:    flagSet.Float64("hue-shift", 0, "Rotate the hue of light and dark shades by up to this many degrees; negative reverses --hue-shift-dir"),
+		hueShiftDir: flagSet.String("hue-shift-dir", string(generator.HueShiftNatural), "Hue shift direction: natural (warm lights, cool darks) or inverse"),
+		tailwind:    flagSet.String("tailwind", string(tailwind.V4), "Tailwind CSS version of color references such as blue-600: v3 or v4"),
+		profile:     flagSet.String("profile", "", "Take the lightness, saturation and hue drift of a default Tailwind palette, e.g. blue, or 'auto' for the nearest hue"),
+		tint:        flagSet.Float64("tint", generator.DefaultTint, "Tint strength of neutral scales, from 0 (pure gray) to 1"),
+		foreground:  flagSet.String("foreground", "", "Contrast target for each shade's text color: wcag[:ratio] or apca[:lc] (default: wcag:4.5)"),
+	}
+}
+
+// settings returns the generation settings of the parsed flags, with the
+// shade scale of --shades-file read in.
+func (f generationFlags) settings() (generationSettings, error) {
+	if *f.shades != "" && *f.shadesFile != "" {
+		return generationSettings{}, ErrorShadesConflict
+	}
+
+	settings := generationSettings{
+		Mode:        *f.mode,
+		Shades:      *f.shades,
+		Anchor:      *f.anchor,
+		HueShift:    f.hueShift,
+		HueShiftDir: *f.hueShiftDir,
+		Foreground:  *f.foreground,
+		Tailwind:    *f.tailwind,
+		Profile:     *f.profile,
+		Tint:        f.tint,
+	}
+	if *f.shadesFile != "" {
+		data, err := os.ReadFile(*f.shadesFile)
+		if err != nil {
+			return generationSettings{}, fmt.Errorf("reading shades file: %w", err)
+		}
+		settings.Shades = string(data)
+	}
+	return settings, nil
+}
+
+// options builds generator options from the parsed flags.
+func (f generationFlags) options() (generator.Options, error) {
+	settings, err := f.settings()
+	if err != nil {
+		return generator.Options{}, err
+	}
+	return buildOptions(settings)
+}
+
+// tailwindVersion returns the Tailwind version whose default colors can be
+// given as color references such as blue-600.
+func (f generationFlags) tailwindVersion() (tailwind.Version, error) {
+	return parseTailwindVersion(*f.tailwind)
+}
+
+// tailwindProfile returns the default Tailwind palette whose profile shades are
+// generated with, if any.
+func (f generationFlags) tailwindProfile() string {
+	return *f.profile
+}
+
 func Main() exitCode {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "theme":
+			return runTheme(os.Args[2:])
+		case "contrast":
+			return runContrast(os.Args[2:])
+		case "nearest":
+			return runNearest(os.Args[2:])
+		case "fit":
+			return runFit(os.Args[2:])
+		}
+	}
+
 	flagSet := flag.NewFlagSet("tailwindcss-palette", flag.ExitOnError)
-	colorFormat := flagSet.String("c", string(HexFormat), "Color format: hex, hsl, or rgb")
-	outputFile := flagSet.String("o", "", "Path to output JSON file (optional)")
+	colorFormat := flagSet.String("c", string(HexFormat), "Color format: hex, hsl, rgb, or oklch")
+	outputFile := flagSet.String("o", "", "Path to output file (optional)")
+	exportFormat := flagSet.String("f", "", "Output file format: json, css, js, esm, ts, dtcg, or tokens-studio (default: from -o extension)")
+	colorName := flagSet.String("n", "primary", "Color name used in exported files for a single unnamed color")
+	base := flagSet.String("base", "", "Base color, instead of a color argument (e.g. sky-400)")
+	apca := flagSet.Bool("apca", false, "Include APCA (WCAG 3 draft) Lc values in JSON exports")
+	deltaE := flagSet.Bool("delta-e", false, "Report the CIEDE2000 color difference between consecutive shades")
+	harmony := flagSet.String("harmony", "", "Also generate palettes for the complementary, analogous, triadic, tetradic or split-complementary colors")
+	simulate := flagSet.String("simulate", "", "Show and export the palette as seen with protanopia, deuteranopia, tritanopia or achromatopsia")
+	noNeutral := flagSet.Bool("no-neutral", false, "Do not add a neutral palette to exports of several colors")
+	generation := addGenerationFlags(flagSet)
 	noColorPtr := flagSet.Bool("no-color", false, "Disable colored output")
 	_ = flagSet.Bool("v", false, "Print version information and exit")
 
 	flagSet.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette <hex-color> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette <color>... [options]\n")
+		fmt.Fprintf(os.Stderr, "       tailwindcss-palette theme <config.json>\n")
+		fmt.Fprintf(os.Stderr, "       tailwindcss-palette contrast <color>... [options]\n")
+		fmt.Fprintf(os.Stderr, "       tailwindcss-palette nearest <color>... [options]\n")
+		fmt.Fprintf(os.Stderr, "       tailwindcss-palette fit <palette-file> [options]\n\n")
 		fmt.Fprintf(os.Stderr, "Arguments:\n")
-		fmt.Fprintf(os.Stderr, "  <hex-color>    Hex color code (e.g. #FF5733 or FF5733)\n\n")
+		fmt.Fprintf(os.Stderr, "  <color>        Any CSS color (e.g. #FF5733, FF5733, \"rgb(59 130 246)\",\n")
+		fmt.Fprintf(os.Stderr, "                 \"oklch(62%% 0.19 259)\" or cornflowerblue) or a default\n")
+		fmt.Fprintf(os.Stderr, "                 Tailwind color such as blue-600, optionally named as\n")
+		fmt.Fprintf(os.Stderr, "                 name=color; several colors generate several palettes\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		fmt.Fprintf(os.Stderr, "  -h, --help     Show this help message\n")
 		fmt.Fprintf(os.Stderr, "  -v, --version  Print version information and exit\n")
@@ -56,7 +212,27 @@ func Main() exitCode {
 		fmt.Fprintf(os.Stderr, "\nExamples:\n")
 		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6                   # Generate palette in hex format\n")
 		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -c rgb            # Generate palette in RGB format\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette blue-600                  # Start from a default Tailwind color\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette \"oklch(62%% 0.19 259)\"    # Generate palette from any CSS color\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -c oklch          # Generate palette in OKLCH format\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --mode oklch     # Generate with perceptual lightness\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --anchor 500     # Keep the base color as shade 500\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --hue-shift 10    # Warm up light shades, cool down dark shades\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --shades 25:99,50:97,500:46,975:2  # Use a custom shade scale\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #7C3AED --profile auto     # Take the profile of the nearest Tailwind hue\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --harmony triadic  # Add palettes for the triadic colors\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --mode neutral    # Generate grays tinted with the base hue\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --delta-e         # Report the color difference between shades\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 --simulate deuteranopia  # Preview the palette as seen with deuteranopia\n")
 		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -o palette.json   # Export to JSON file\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -o theme.css -n brand -c oklch  # Export a Tailwind v4 @theme block\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -o colors.ts -n brand          # Export a Tailwind v3 colors module\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #3B82F6 -o brand.tokens.json -n brand  # Export W3C design tokens\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette primary=#3B82F6 accent=#F97316 -o theme.css  # Export several named colors\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette theme palette.json        # Generate every color of a theme config\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette contrast #3B82F6          # Report WCAG contrast of every shade\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette nearest #3B82F6           # List the closest default Tailwind colors\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette fit brand.css -o brand.shades  # Fit a shade scale to an existing palette\n")
 	}
 
 	for _, arg := range os.Args[1:] {
@@ -70,49 +246,116 @@ func Main() exitCode {
 		}
 	}
 
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Error: Missing hex color argument\n\n")
-		flagSet.Usage()
+	colors, err := parseColorArgs(flagSet, os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
 		return exitError
 	}
 
-	hexColor := os.Args[1]
-
-	if len(os.Args) > 2 {
-		if err := flagSet.Parse(os.Args[2:]); err != nil {
-			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+	if *base != "" {
+		if len(colors) > 0 {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", ErrorBaseConflict)
 			return exitError
 		}
+		colors = []string{*base}
+	}
+	if len(colors) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: Missing color argument\n\n")
+		flagSet.Usage()
+		return exitError
 	}
 
 	format := ColorFormat(strings.ToLower(*colorFormat))
-	if format != HexFormat && format != HSLFormat && format != RGBFormat {
+	if !validColorFormat(format) {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", ErrorInvalidFormat)
 		return exitError
 	}
 
-	if !strings.HasPrefix(hexColor, "#") {
-		hexColor = "#" + hexColor
+	settings, err := generation.settings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
 	}
-
-	palette, err := generator.GeneratePaletteFromHex(hexColor, generator.DefaultTailwindOptions())
+	opts, err := buildOptions(settings)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+	tailwindVersion, err := generation.tailwindVersion()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		return exitError
 	}
 
+	palettes, err := generateColors(colors, strings.ToLower(*colorName), opts, tailwindVersion, generation.tailwindProfile())
+	if err != nil {
+		printColorError(err)
+		return exitError
+	}
+
+	if *harmony != "" {
+		h, err := color.ParseHarmony(*harmony)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		palettes, err = harmonyPalettes(palettes, h, opts, tailwindVersion, generation.tailwindProfile())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+	}
+
+	if *outputFile != "" && !*noNeutral && len(palettes) > 1 {
+		palettes, err = addNeutral(palettes, settings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+	}
+
+	if *simulate != "" {
+		deficiency, err := color.ParseDeficiency(*simulate)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		palettes, err = simulatePalettes(os.Stderr, palettes, deficiency)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+	}
+
 	if *outputFile != "" {
-		if err := writeToJSONFile(palette, hexColor, *outputFile); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing to file: %v\n", err)
+		export := ExportFormat(strings.ToLower(*exportFormat))
+		if export == "" {
+			export = exportFormatFromPath(*outputFile)
+		}
+
+		if err := writeOutput(palettes, export, format, *apca, *outputFile); err != nil {
+			if errors.Is(err, ErrorInvalidExport) || errors.Is(err, ErrorReservedIdentifier) || errors.Is(err, ErrorDuplicateIdentifier) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			} else {
+				fmt.Fprintf(os.Stderr, "Error writing to file: %v\n", err)
+			}
 			return exitError
 		}
 		fmt.Printf("Palette has been written to %s\n", *outputFile)
-		return exitOK
+		return reportShadeSteps(palettes, *deltaE)
 	}
 
 	useColor := !*noColorPtr && isTerminal()
 
-	baseHex := hexColor
+	if len(palettes) > 1 {
+		if err := outputPalettes(palettes, format, useColor); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		return reportShadeSteps(palettes, *deltaE)
+	}
+
+	baseHex := palettes[0].palette.Base
 	if useColor {
 		colorBlock := getColorBlock(baseHex)
 		fmt.Printf("Base color: %s %s\n", baseHex, colorBlock)
@@ -120,19 +363,14 @@ func Main() exitCode {
 		fmt.Printf("Base color: %s\n", baseHex)
 	}
 
-	h, s, l, _ := color.HexToHSL(baseHex)
-	r, g, b, _ := color.HexToRGB(baseHex)
-
 	switch format {
-	case HSLFormat:
-		fmt.Printf("HSL: hsl(%3.0f, %3.0f%%, %3.0f%%)", h, s*100, l*100)
-		if useColor {
-			fmt.Printf(" %s\n", getColorBlock(baseHex))
-		} else {
-			fmt.Println()
+	case HSLFormat, RGBFormat, OKLCHFormat:
+		value, err := terminalColor(baseHex, format)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
 		}
-	case RGBFormat:
-		fmt.Printf("RGB: rgb(%3d, %3d, %3d)", r, g, b)
+		fmt.Printf("%s: %s", strings.ToUpper(string(format)), value)
 		if useColor {
 			fmt.Printf(" %s\n", getColorBlock(baseHex))
 		} else {
@@ -143,125 +381,463 @@ func Main() exitCode {
 	fmt.Println("\nTailwind CSS palette:")
 	fmt.Println("---------------------")
 
-	if err := outputPalette(palette, format, useColor); err != nil {
+	if err := outputPalette(palettes[0].palette, format, useColor); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		return exitError
 	}
 
+	return reportShadeSteps(palettes, *deltaE)
+}
+
+// reportShadeSteps prints the ΔE between consecutive shades if requested.
+func reportShadeSteps(palettes []namedPalette, deltaE bool) exitCode {
+	if !deltaE {
+		return exitOK
+	}
+	if err := writeShadeSteps(os.Stdout, palettes); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
 	return exitOK
 }
 
-func outputPalette(palette map[string]string, format ColorFormat, useColor bool) error {
-	keys := []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "950"}
+// printColorError prints err, pointing at the offending token when a color
+// failed to parse.
+func printColorError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+
+	var parseErr *color.ParseError
+	if errors.As(err, &parseErr) {
+		indent := strings.Repeat(" ", utf8.RuneCountInString(parseErr.Input[:parseErr.Offset]))
+		marker := strings.Repeat("^", max(1, utf8.RuneCountInString(parseErr.Token)))
+		fmt.Fprintf(os.Stderr, "  %s\n  %s%s\n", parseErr.Input, indent, marker)
+	}
+}
+
+// splitColorArgs splits the leading color arguments from the flags that
+// follow them. A lone "-" is an argument, not a flag.
+func splitColorArgs(args []string) (colors, flags []string) {
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "-") && arg != "-" {
+			return args[:i], args[i:]
+		}
+	}
+	return args, nil
+}
 
-	for _, key := range keys {
-		hexValue, exists := palette[key]
-		if !exists {
-			continue
+// parseColorArgs parses flagSet from args and returns the color arguments,
+// which may come before, between or after the flags. Everything after "--"
+// is a color argument.
+func parseColorArgs(flagSet *flag.FlagSet, args []string) ([]string, error) {
+	var colors []string
+	for {
+		leading, flagArgs := splitColorArgs(args)
+		colors = append(colors, leading...)
+		if len(flagArgs) == 0 {
+			return colors, nil
 		}
+		if err := flagSet.Parse(flagArgs); err != nil {
+			return nil, err
+		}
+		args = flagSet.Args()
+		if parsed := len(flagArgs) - len(args); parsed > 0 && flagArgs[parsed-1] == "--" {
+			return append(colors, args...), nil
+		}
+	}
+}
 
-		switch format {
-		case HexFormat:
-			fmt.Printf("  %-4s: %-9s", key, hexValue)
-			if useColor {
-				fmt.Printf(" %s\n", getColorBlock(hexValue))
-			} else {
-				fmt.Println()
+// generateColors generates a palette for every color argument. Arguments are
+// either a bare color or name=color, where the color may also be a default
+// Tailwind color of version such as blue-600. A lone bare color is named
+// defaultName, bare colors among several are named color-1, color-2, ... by
+// position.
+func generateColors(args []string, defaultName string, opts generator.Options, version tailwind.Version, profile string) ([]namedPalette, error) {
+	palettes := make([]namedPalette, 0, len(args))
+	seen := make(map[string]bool)
+
+	for i, arg := range args {
+		name, value, named := strings.Cut(arg, "=")
+		if !named {
+			value = arg
+			name = defaultName
+			if len(args) > 1 {
+				name = fmt.Sprintf("color-%d", i+1)
 			}
-		case HSLFormat:
-			h, s, l, err := color.HexToHSL(hexValue)
-			if err != nil {
-				return err
+		}
+
+		name = strings.ToLower(name)
+		if !colorNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: %q", ErrorInvalidName, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %q", ErrorDuplicateName, name)
+		}
+		seen[name] = true
+
+		value, err := resolveColor(value, version)
+		if err != nil {
+			return nil, err
+		}
+		colorOpts, err := applyProfile(opts, profile, version, value)
+		if err != nil {
+			return nil, err
+		}
+		palette, err := generator.GeneratePalette(value, colorOpts)
+		if err != nil {
+			if len(args) > 1 {
+				return nil, fmt.Errorf("%s: %w", name, err)
 			}
-			hslString := fmt.Sprintf("hsl(%3.0f, %3.0f%%, %3.0f%%)", h, s*100, l*100)
-			fmt.Printf("  %-4s: %-25s", key, hslString)
-			if useColor {
-				fmt.Printf(" %s\n", getColorBlock(hexValue))
-			} else {
-				fmt.Println()
+			return nil, err
+		}
+		palettes = append(palettes, namedPalette{name: name, palette: palette})
+	}
+
+	return palettes, nil
+}
+
+func outputPalette(palette generator.Palette, format ColorFormat, useColor bool) error {
+	width := 4
+	for _, swatch := range palette.Swatches {
+		width = max(width, len(swatch.Name))
+	}
+
+	for _, swatch := range palette.Swatches {
+		value, err := terminalColor(swatch.Hex, format)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("  %-*s: %-*s", width, swatch.Name, terminalWidth(format, translucent(palette)), value)
+		if useColor {
+			fmt.Printf(" %s\n", getSampleBlock(swatch))
+		} else {
+			fmt.Println()
+		}
+	}
+
+	return nil
+}
+
+// outputPalettes prints several palettes side by side, one column per
+// palette and one row per shade.
+func outputPalettes(palettes []namedPalette, format ColorFormat, useColor bool) error {
+	nameWidth := 4
+	var rows []string
+	seen := make(map[string]bool)
+	for _, p := range palettes {
+		nameWidth = max(nameWidth, len(p.name))
+		for _, swatch := range p.palette.Swatches {
+			if !seen[swatch.Name] {
+				seen[swatch.Name] = true
+				rows = append(rows, swatch.Name)
 			}
-		case RGBFormat:
-			r, g, b, err := color.HexToRGB(hexValue)
+		}
+	}
+
+	fmt.Println("Base colors:")
+	for _, p := range palettes {
+		fmt.Printf("  %-*s: %s", nameWidth, p.name, p.palette.Base)
+		if useColor {
+			fmt.Printf(" %s\n", getColorBlock(p.palette.Base))
+		} else {
+			fmt.Println()
+		}
+	}
+
+	shadeWidth := 4
+	for _, row := range rows {
+		shadeWidth = max(shadeWidth, len(row))
+	}
+
+	valueWidth := 0
+	for _, p := range palettes {
+		valueWidth = max(valueWidth, terminalWidth(format, translucent(p.palette)))
+	}
+
+	cellWidth := valueWidth
+	if useColor {
+		cellWidth += len(colorBlock) + 1
+	}
+	for _, p := range palettes {
+		cellWidth = max(cellWidth, len(p.name))
+	}
+
+	fmt.Println("\nTailwind CSS palettes:")
+	fmt.Println("----------------------")
+
+	fmt.Printf("  %-*s  ", shadeWidth, "")
+	for _, p := range palettes {
+		fmt.Printf("  %-*s", cellWidth, p.name)
+	}
+	fmt.Println()
+
+	for _, row := range rows {
+		fmt.Printf("  %-*s: ", shadeWidth, row)
+		for _, p := range palettes {
+			swatch, exists := p.palette.Swatch(row)
+			if !exists {
+				fmt.Printf("  %-*s", cellWidth, "")
+				continue
+			}
+
+			value, err := terminalColor(swatch.Hex, format)
 			if err != nil {
 				return err
 			}
-			rgbString := fmt.Sprintf("rgb(%3d, %3d, %3d)", r, g, b)
-			fmt.Printf("  %-4s: %-20s", key, rgbString)
 			if useColor {
-				fmt.Printf(" %s\n", getColorBlock(hexValue))
+				fmt.Printf("  %-*s %s", valueWidth, value, getSampleBlock(swatch))
+				fmt.Printf("%*s", cellWidth-valueWidth-len(colorBlock)-1, "")
 			} else {
-				fmt.Println()
+				fmt.Printf("  %-*s", cellWidth, value)
 			}
 		}
+		fmt.Println()
 	}
 
 	return nil
 }
 
-func isTerminal() bool {
-	fileInfo, err := os.Stdout.Stat()
+// terminalColor formats a hex color for terminal output, padding the
+// channels so that values line up in columns.
+func terminalColor(hexValue string, format ColorFormat) (string, error) {
+	c, err := color.ParseHex(hexValue)
 	if err != nil {
-		return false
+		return "", err
+	}
+
+	alpha := c.A != 255
+	switch format {
+	case HSLFormat:
+		h, s, l := c.HSL()
+		if alpha {
+			return fmt.Sprintf("hsla(%3.0f, %3.0f%%, %3.0f%%, %.2f)", h, s*100, l*100, c.Alpha()), nil
+		}
+		return fmt.Sprintf("hsl(%3.0f, %3.0f%%, %3.0f%%)", h, s*100, l*100), nil
+	case RGBFormat:
+		if alpha {
+			return fmt.Sprintf("rgba(%3d, %3d, %3d, %.2f)", c.R, c.G, c.B, c.Alpha()), nil
+		}
+		return fmt.Sprintf("rgb(%3d, %3d, %3d)", c.R, c.G, c.B), nil
+	case OKLCHFormat:
+		l, ch, h := c.OKLCH()
+		value := formatOKLCH(l, ch, h)
+		if alpha {
+			value = fmt.Sprintf("%s / %.2f)", strings.TrimSuffix(value, ")"), c.Alpha())
+		}
+		return value, nil
+	default:
+		return c.Hex(), nil
 	}
-	return (fileInfo.Mode() & os.ModeCharDevice) != 0
 }
 
-func getColorBlock(hex string) string {
-	r, g, b, err := color.HexToRGB(hex)
-	if err != nil {
-		return colorBlock + colorReset
+// terminalWidth is the column width of terminal values in format, wider
+// when they carry an alpha channel.
+func terminalWidth(format ColorFormat, alpha bool) int {
+	switch {
+	case format == HSLFormat && alpha:
+		return 27
+	case format == OKLCHFormat && alpha:
+		return 32
+	case format == RGBFormat && alpha:
+		return 25
+	case format == HSLFormat, format == OKLCHFormat:
+		return 25
+	case format == RGBFormat:
+		return 20
+	default:
+		return 9
 	}
-	return fmt.Sprintf("\033[48;2;%d;%d;%dm%s%s", r, g, b, colorBlock, colorReset)
 }
 
-func writeToJSONFile(palette map[string]string, baseColor string, filePath string) error {
-	paletteData := map[string]any{
-		"base": map[string]any{
-			"hex": baseColor,
-		},
-		"palette": map[string]map[string]any{},
+// translucent reports whether a palette was generated from a color with
+// alpha.
+func translucent(palette generator.Palette) bool {
+	c, err := color.ParseHex(palette.Base)
+	return err == nil && c.A != 255
+}
+
+func formatOKLCH(l, c, h float64) string {
+	return fmt.Sprintf("oklch(%5.1f%% %.3f %5.1f)", l*100, c, h)
+}
+
+func validColorFormat(format ColorFormat) bool {
+	return format == HexFormat || format == HSLFormat || format == RGBFormat || format == OKLCHFormat
+}
+
+func validExportFormat(export ExportFormat) bool {
+	switch export {
+	case JSONExport, CSSExport, CommonJSExport, ESMExport, TypeScriptExport, DTCGExport, TokensStudioExport:
+		return true
+	}
+	return false
+}
+
+func buildOptions(settings generationSettings) (generator.Options, error) {
+	var opts generator.Options
+	switch generator.Mode(strings.ToLower(settings.Mode)) {
+	case "", generator.ModeHSL:
+		opts = generator.DefaultTailwindOptions()
+	case generator.ModeOKLCH:
+		opts = generator.DefaultPerceptualOptions()
+	case generator.ModeNeutral:
+		opts = generator.DefaultNeutralOptions()
+	default:
+		return opts, generator.ErrorInvalidMode
 	}
 
-	h, s, l, err := color.HexToHSL(baseColor)
-	if err == nil {
-		paletteData["base"].(map[string]any)["hsl"] = map[string]any{
-			"h": int(h),
-			"s": s,
-			"l": l,
+	if strings.TrimSpace(settings.Shades) != "" {
+		if settings.Profile != "" {
+			return opts, ErrorProfileConflict
 		}
+		shades, err := generator.ParseShades(settings.Shades)
+		if err != nil {
+			return opts, err
+		}
+		opts = generator.NewOptions(shades).WithMode(opts.Mode())
 	}
 
-	r, g, b, err := color.HexToRGB(baseColor)
-	if err == nil {
-		paletteData["base"].(map[string]any)["rgb"] = map[string]any{
-			"r": r,
-			"g": g,
-			"b": b,
-		}
+	direction := generator.HueShiftDirection(strings.ToLower(settings.HueShiftDir))
+	switch direction {
+	case "":
+		direction = generator.HueShiftNatural
+	case generator.HueShiftNatural, generator.HueShiftInverse:
+	default:
+		return opts, generator.ErrorInvalidHueShift
 	}
 
-	for shade, hexValue := range palette {
-		shadeData := map[string]any{
-			"hex": hexValue,
+	var degrees float64
+	if settings.HueShift != nil {
+		degrees = *settings.HueShift
+		if math.IsNaN(degrees) || math.IsInf(degrees, 0) {
+			return opts, generator.ErrorInvalidHueDegrees
 		}
+	}
 
-		if h, s, l, err := color.HexToHSL(hexValue); err == nil {
-			shadeData["hsl"] = map[string]any{
-				"h": int(h),
-				"s": s,
-				"l": l,
-			}
+	if opts.Mode() == generator.ModeNeutral && settings.Anchor != "" {
+		return opts, generator.ErrorNeutralAnchor
+	}
+	opts = opts.WithAnchor(settings.Anchor).WithHueShift(degrees, direction)
+	if settings.Tint != nil {
+		if *settings.Tint < 0 || *settings.Tint > 1 {
+			return opts, generator.ErrorInvalidTint
 		}
+		opts = opts.WithTint(*settings.Tint)
+	}
+	if settings.Foreground != "" {
+		method, target, err := parseForeground(settings.Foreground)
+		if err != nil {
+			return opts, err
+		}
+		opts = opts.WithForeground(method, target)
+	}
+	return opts, nil
+}
 
-		if r, g, b, err := color.HexToRGB(hexValue); err == nil {
-			shadeData["rgb"] = map[string]any{
-				"r": r,
-				"g": g,
-				"b": b,
-			}
+// parseForeground parses a foreground contrast target such as "wcag:7" or
+// "apca:75". Without a target, WCAG defaults to 4.5 and APCA to Lc 60.
+func parseForeground(s string) (generator.ContrastMethod, float64, error) {
+	name, value, hasTarget := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	method := generator.ContrastMethod(name)
+
+	var target float64
+	switch method {
+	case generator.ContrastWCAG:
+		target = generator.DefaultForegroundTarget
+	case generator.ContrastAPCA:
+		target = generator.DefaultAPCAForegroundTarget
+	default:
+		return "", 0, generator.ErrorInvalidContrastMethod
+	}
+
+	if hasTarget {
+		var err error
+		target, err = strconv.ParseFloat(value, 64)
+		if err != nil || target < 0 {
+			return "", 0, generator.ErrorInvalidContrastTarget
 		}
+	}
+	return method, target, nil
+}
+
+// writeOutput writes palettes to filePath in the given export format.
+// With apca, JSON exports include the APCA Lc of every shade.
+func writeOutput(palettes []namedPalette, export ExportFormat, format ColorFormat, apca bool, filePath string) error {
+	switch export {
+	case JSONExport:
+		return writeToJSONFile(palettes, apca, filePath)
+	case CSSExport:
+		return writeToCSSFile(palettes, format, filePath)
+	case CommonJSExport, ESMExport, TypeScriptExport:
+		return writeToJSFile(palettes, format, export, filePath)
+	case DTCGExport, TokensStudioExport:
+		return writeToTokensFile(palettes, format, export, filePath)
+	default:
+		return ErrorInvalidExport
+	}
+}
+
+func exportFormatFromPath(path string) ExportFormat {
+	lower := strings.ToLower(path)
+	if strings.HasSuffix(lower, ".tokens.json") || strings.HasSuffix(lower, ".tokens") {
+		return DTCGExport
+	}
+
+	switch filepath.Ext(lower) {
+	case ".css":
+		return CSSExport
+	case ".js", ".cjs":
+		return CommonJSExport
+	case ".mjs":
+		return ESMExport
+	case ".ts", ".mts":
+		return TypeScriptExport
+	default:
+		return JSONExport
+	}
+}
+
+func isTerminal() bool {
+	fileInfo, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return (fileInfo.Mode() & os.ModeCharDevice) != 0
+}
+
+func getColorBlock(hex string) string {
+	c, err := color.ParseHex(hex)
+	if err != nil {
+		return colorBlock + colorReset
+	}
+	return fmt.Sprintf("\033[48;2;%d;%d;%dm%s%s", c.R, c.G, c.B, colorBlock, colorReset)
+}
+
+// getSampleBlock renders sample text in the swatch's foreground on the
+// swatch, as wide as a color block.
+func getSampleBlock(swatch generator.Swatch) string {
+	bg, err := color.ParseHex(swatch.Hex)
+	if err != nil {
+		return colorBlock + colorReset
+	}
+	fg, err := color.ParseHex(swatch.Foreground)
+	if err != nil {
+		return getColorBlock(swatch.Hex)
+	}
+	return fmt.Sprintf("\033[48;2;%d;%d;%dm\033[38;2;%d;%d;%dm%s%s", bg.R, bg.G, bg.B, fg.R, fg.G, fg.B, sampleText, colorReset)
+}
 
-		paletteData["palette"].(map[string]map[string]any)[shade] = shadeData
+// writeToJSONFile writes a single palette as an object with its base color
+// and shades, and several palettes as an object of those keyed by name.
+func writeToJSONFile(palettes []namedPalette, apca bool, filePath string) error {
+	var data any
+	if len(palettes) == 1 {
+		data = paletteJSON(palettes[0].palette, apca)
+	} else {
+		theme := orderedObject{}
+		for _, p := range palettes {
+			theme.set(p.name, paletteJSON(p.palette, apca))
+		}
+		data = theme
 	}
 
 	file, err := os.Create(filePath)
@@ -272,5 +848,75 @@ func writeToJSONFile(palette map[string]string, baseColor string, filePath strin
 
 	encoder := json.NewEncoder(file)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(paletteData)
+	return encoder.Encode(data)
+}
+
+func paletteJSON(palette generator.Palette, apca bool) map[string]any {
+	shades := orderedObject{}
+	for _, swatch := range palette.Swatches {
+		data := colorJSON(swatch.Hex)
+		if c, err := color.ParseHex(swatch.Hex); err == nil && apca {
+			data["apca"] = apcaJSON(c)
+		}
+		if swatch.Foreground != "" {
+			data["foreground"] = foregroundJSON(swatch)
+		}
+		shades.set(swatch.Name, data)
+	}
+
+	return map[string]any{
+		"base":    colorJSON(palette.Base),
+		"palette": shades,
+	}
+}
+
+// foregroundJSON is the text color of a swatch, with the palette shade it
+// was taken from, if any.
+func foregroundJSON(swatch generator.Swatch) map[string]any {
+	data := map[string]any{
+		"hex": swatch.Foreground,
+	}
+	if swatch.ForegroundShade != "" {
+		data["shade"] = swatch.ForegroundShade
+	}
+	return data
+}
+
+func colorJSON(hexValue string) map[string]any {
+	data := map[string]any{
+		"hex": hexValue,
+	}
+
+	c, err := color.ParseHex(hexValue)
+	if err != nil {
+		return data
+	}
+
+	h, s, l := c.HSL()
+	data["hsl"] = map[string]any{
+		"h": int(h),
+		"s": s,
+		"l": l,
+	}
+	data["rgb"] = map[string]any{
+		"r": c.R,
+		"g": c.G,
+		"b": c.B,
+	}
+	l, ch, h := c.OKLCH()
+	data["oklch"] = oklchData(l, ch, h)
+
+	if c.A != 255 {
+		data["alpha"] = roundAlpha(c.Alpha())
+	}
+
+	return data
+}
+
+func oklchData(l, c, h float64) map[string]any {
+	return map[string]any{
+		"l": math.Round(l*1000) / 1000,
+		"c": math.Round(c*1000) / 1000,
+		"h": math.Round(h*100) / 100,
+	}
 }
@@ -0,0 +1,100 @@
+package clicmd
+
+import (
+	"flag"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestParseColorArgs(t *testing.T) {
+	tests := map[string]struct {
+		args       []string
+		wantColors []string
+		wantFormat string
+	}{
+		"Colors before flags": {
+			args:       []string{"#3B82F6", "#F97316", "-c", "rgb"},
+			wantColors: []string{"#3B82F6", "#F97316"},
+			wantFormat: "rgb",
+		},
+		"Colors between and after flags": {
+			args:       []string{"#3B82F6", "-c", "rgb", "#F97316", "--hue-shift", "-5", "accent=#22C55E"},
+			wantColors: []string{"#3B82F6", "#F97316", "accent=#22C55E"},
+			wantFormat: "rgb",
+		},
+		"Lone dash": {
+			args:       []string{"-"},
+			wantColors: []string{"-"},
+			wantFormat: "hex",
+		},
+		"Lone dash after a color": {
+			args:       []string{"#3B82F6", "-"},
+			wantColors: []string{"#3B82F6", "-"},
+			wantFormat: "hex",
+		},
+		"Lone dash after flags": {
+			args:       []string{"-c", "rgb", "-", "#3B82F6"},
+			wantColors: []string{"-", "#3B82F6"},
+			wantFormat: "rgb",
+		},
+		"Arguments after a double dash": {
+			args:       []string{"-c", "rgb", "--", "-c", "#3B82F6"},
+			wantColors: []string{"-c", "#3B82F6"},
+			wantFormat: "rgb",
+		},
+		"Flags only": {
+			args:       []string{"-c", "hsl"},
+			wantFormat: "hsl",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+			format := flagSet.String("c", "hex", "")
+			_ = flagSet.Float64("hue-shift", 0, "")
+
+			colors, err := parseColorArgs(flagSet, tt.args)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if !slices.Equal(colors, tt.wantColors) {
+				t.Errorf("colors = %v, want %v", colors, tt.wantColors)
+			}
+			if *format != tt.wantFormat {
+				t.Errorf("-c = %q, want %q", *format, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestBuildOptionsHueShift(t *testing.T) {
+	tests := map[string]struct {
+		degrees float64
+		wantErr error
+	}{
+		"Positive":       {degrees: 10},
+		"Negative":       {degrees: -10},
+		"NaN":            {degrees: math.NaN(), wantErr: generator.ErrorInvalidHueDegrees},
+		"Infinite":       {degrees: math.Inf(1), wantErr: generator.ErrorInvalidHueDegrees},
+		"Minus infinite": {degrees: math.Inf(-1), wantErr: generator.ErrorInvalidHueDegrees},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, err := buildOptions(generationSettings{HueShift: &tt.degrees})
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if degrees, _ := opts.HueShift(); degrees != tt.degrees {
+				t.Errorf("hue shift = %v, want %v", degrees, tt.degrees)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package clicmd
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+// ReportFormat is the format of the contrast report.
+type ReportFormat string
+
+const (
+	TextReport ReportFormat = "text"
+	JSONReport ReportFormat = "json"
+)
+
+var (
+	ErrorInvalidReport = errors.New("invalid report format: must be text or json")
+)
+
+const (
+	whiteHex = "#FFFFFF"
+	blackHex = "#000000"
+)
+
+// contrastPair is the contrast of a shade against another color: the WCAG
+// ratio, and the APCA Lc of the other color as text on the shade and of the
+// shade as text on the other color as background.
+type contrastPair struct {
+	ratio             float64
+	rating            color.WCAGRating
+	textOnShade       float64
+	shadeOnBackground float64
+}
+
+// shadeContrast is the contrast of one shade against white, black and
+// every shade of its palette, in scale order.
+type shadeContrast struct {
+	name      string
+	hex       string
+	luminance float64
+	white     contrastPair
+	black     contrastPair
+	shades    []contrastPair
+}
+
+func runContrast(args []string) exitCode {
+	flagSet := flag.NewFlagSet("tailwindcss-palette contrast", flag.ExitOnError)
+	reportFormat := flagSet.String("f", "", "Report format: text or json (default: json for a .json -o path, else text)")
+	outputFile := flagSet.String("o", "", "Path to write the report to instead of printing it (optional)")
+	colorName := flagSet.String("n", "primary", "Color name used in the report for a single unnamed color")
+	apca := flagSet.Bool("apca", false, "Include APCA (WCAG 3 draft) Lc values")
+	generation := addGenerationFlags(flagSet)
+	noColorPtr := flagSet.Bool("no-color", false, "Disable colored output")
+
+	flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette contrast <color>... [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Reports the WCAG 2.x contrast ratio of every shade against white, black\n")
+		fmt.Fprintf(os.Stderr, "and every other shade of the palette, and with --apca their APCA Lc.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flagSet.PrintDefaults()
+	}
+
+	for _, arg := range args {
+		if arg == "-h" || arg == "--help" {
+			flagSet.Usage()
+			return exitOK
+		}
+	}
+
+	colors, err := parseColorArgs(flagSet, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+		return exitError
+	}
+	if len(colors) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: Missing color argument\n\n")
+		flagSet.Usage()
+		return exitError
+	}
+
+	format := ReportFormat(strings.ToLower(*reportFormat))
+	if format == "" {
+		format = TextReport
+		if strings.HasSuffix(strings.ToLower(*outputFile), ".json") {
+			format = JSONReport
+		}
+	}
+	if format != TextReport && format != JSONReport {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", ErrorInvalidReport)
+		return exitError
+	}
+
+	opts, err := generation.options()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+	tailwindVersion, err := generation.tailwindVersion()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	palettes, err := generateColors(colors, strings.ToLower(*colorName), opts, tailwindVersion, generation.tailwindProfile())
+	if err != nil {
+		printColorError(err)
+		return exitError
+	}
+
+	out := io.Writer(os.Stdout)
+	useColor := !*noColorPtr && isTerminal()
+	if *outputFile != "" {
+		file, err := os.Create(*outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing to file: %v\n", err)
+			return exitError
+		}
+		defer file.Close()
+		out = file
+		useColor = false
+	}
+
+	if format == JSONReport {
+		err = writeContrastJSON(out, palettes, *apca)
+	} else {
+		err = writeContrastText(out, palettes, *apca, useColor)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	if *outputFile != "" {
+		fmt.Printf("Contrast report has been written to %s\n", *outputFile)
+	}
+	return exitOK
+}
+
+// contrastReport computes the contrast of every shade of palette.
+func contrastReport(palette generator.Palette) ([]shadeContrast, error) {
+	white, _ := color.ParseHex(whiteHex)
+	black, _ := color.ParseHex(blackHex)
+
+	shades := make([]color.Color, len(palette.Swatches))
+	for i, swatch := range palette.Swatches {
+		c, err := color.ParseHex(swatch.Hex)
+		if err != nil {
+			return nil, err
+		}
+		shades[i] = c
+	}
+
+	report := make([]shadeContrast, len(shades))
+	for i, c := range shades {
+		report[i] = shadeContrast{
+			name:      palette.Swatches[i].Name,
+			hex:       palette.Swatches[i].Hex,
+			luminance: c.Luminance(),
+			white:     newContrastPair(c, white),
+			black:     newContrastPair(c, black),
+			shades:    make([]contrastPair, len(shades)),
+		}
+		for j, other := range shades {
+			report[i].shades[j] = newContrastPair(c, other)
+		}
+	}
+
+	return report, nil
+}
+
+func newContrastPair(shade, other color.Color) contrastPair {
+	ratio := shade.ContrastRatio(other)
+	return contrastPair{
+		ratio:             ratio,
+		rating:            color.RateContrast(ratio),
+		textOnShade:       other.APCAContrast(shade),
+		shadeOnBackground: shade.APCAContrast(other),
+	}
+}
+
+// wcagMark is the highest WCAG level a rating passes: AAA, AA, or AA18 for
+// large text only.
+func wcagMark(rating color.WCAGRating) string {
+	switch {
+	case rating.AAA:
+		return "AAA"
+	case rating.AA:
+		return "AA"
+	case rating.AALarge:
+		return "AA18"
+	default:
+		return ""
+	}
+}
+
+func formatContrast(pair contrastPair) string {
+	return fmt.Sprintf("%5.2f %-4s", pair.ratio, wcagMark(pair.rating))
+}
+
+func writeContrastText(w io.Writer, palettes []namedPalette, apca, useColor bool) error {
+	for i, p := range palettes {
+		report, err := contrastReport(p.palette)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s (%s)\n\n", p.name, p.palette.Base)
+
+		width := 5
+		for _, shade := range report {
+			width = max(width, len(shade.name))
+		}
+
+		fmt.Fprintf(w, "  %-*s  %-9s  %-9s  %-10s  %-10s\n", width, "Shade", "Hex", "Luminance", "White", "Black")
+		for _, shade := range report {
+			fmt.Fprintf(w, "  %-*s  %-9s  %-9.4f  %s  %s", width, shade.name, shade.hex, shade.luminance,
+				formatContrast(shade.white), formatContrast(shade.black))
+			if useColor {
+				fmt.Fprintf(w, "  %s", getColorBlock(shade.hex))
+			}
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "\n  %-*s", width, "")
+		for _, shade := range report {
+			fmt.Fprintf(w, "  %-10s", shade.name)
+		}
+		fmt.Fprintln(w)
+		for _, shade := range report {
+			fmt.Fprintf(w, "  %-*s", width, shade.name)
+			for _, pair := range shade.shades {
+				fmt.Fprintf(w, "  %s", formatContrast(pair))
+			}
+			fmt.Fprintln(w)
+		}
+
+		if apca {
+			writeAPCAText(w, report, width)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "AAA: 7:1, AAA normal text. AA: 4.5:1, AA normal and AAA large text.")
+	fmt.Fprintln(w, "AA18: 3:1, AA large text (18pt, or 14pt bold) only.")
+	return nil
+}
+
+// writeAPCAText prints the APCA Lc of white and black text on each shade,
+// of each shade on white and black backgrounds, and of each shade as text
+// (columns) on every shade (rows).
+func writeAPCAText(w io.Writer, report []shadeContrast, width int) {
+	fmt.Fprintf(w, "\n  APCA Lc\n\n")
+	fmt.Fprintf(w, "  %-*s  %10s  %10s  %10s  %10s\n", width, "Shade", "White text", "Black text", "On white", "On black")
+	for _, shade := range report {
+		fmt.Fprintf(w, "  %-*s  %10.1f  %10.1f  %10.1f  %10.1f\n", width, shade.name,
+			shade.white.textOnShade, shade.black.textOnShade,
+			shade.white.shadeOnBackground, shade.black.shadeOnBackground)
+	}
+
+	fmt.Fprintf(w, "\n  Text (columns) on shade (rows)\n\n")
+	fmt.Fprintf(w, "  %-*s", width, "")
+	for _, shade := range report {
+		fmt.Fprintf(w, "  %6s", shade.name)
+	}
+	fmt.Fprintln(w)
+	for _, shade := range report {
+		fmt.Fprintf(w, "  %-*s", width, shade.name)
+		for _, pair := range shade.shades {
+			fmt.Fprintf(w, "  %6.1f", pair.textOnShade)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func writeContrastJSON(w io.Writer, palettes []namedPalette, apca bool) error {
+	reports := make([]orderedObject, len(palettes))
+	for i, p := range palettes {
+		report, err := contrastReport(p.palette)
+		if err != nil {
+			return err
+		}
+		reports[i] = contrastJSON(p.palette.Base, report, apca)
+	}
+
+	var data any = reports[0]
+	if len(palettes) > 1 {
+		var byName orderedObject
+		for i, p := range palettes {
+			byName.set(p.name, reports[i])
+		}
+		data = byName
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+func contrastJSON(base string, report []shadeContrast, apca bool) orderedObject {
+	shades := make([]orderedObject, len(report))
+	for i, shade := range report {
+		var others orderedObject
+		for j, pair := range shade.shades {
+			others.set(report[j].name, contrastPairJSON(pair, apca))
+		}
+
+		shades[i].set("name", shade.name)
+		shades[i].set("hex", shade.hex)
+		shades[i].set("luminance", math.Round(shade.luminance*10000)/10000)
+		shades[i].set("white", contrastPairJSON(shade.white, apca))
+		shades[i].set("black", contrastPairJSON(shade.black, apca))
+		shades[i].set("shades", others)
+	}
+
+	var data orderedObject
+	data.set("base", base)
+	data.set("shades", shades)
+	return data
+}
+
+func contrastPairJSON(pair contrastPair, apca bool) orderedObject {
+	var data orderedObject
+	data.set("ratio", math.Round(pair.ratio*100)/100)
+	data.set("aa", pair.rating.AA)
+	data.set("aaLarge", pair.rating.AALarge)
+	data.set("aaa", pair.rating.AAA)
+	data.set("aaaLarge", pair.rating.AAALarge)
+	if apca {
+		var lc orderedObject
+		lc.set("textOnShade", roundLc(pair.textOnShade))
+		lc.set("shadeOnBackground", roundLc(pair.shadeOnBackground))
+		data.set("apca", lc)
+	}
+	return data
+}
+
+// roundLc rounds an APCA Lc value to one decimal.
+func roundLc(lc float64) float64 {
+	return math.Round(lc*10) / 10
+}
+
+// apcaJSON is the APCA Lc of white and black text on a shade and of the
+// shade as text on white and black backgrounds.
+func apcaJSON(c color.Color) map[string]any {
+	white, _ := color.ParseHex(whiteHex)
+	black, _ := color.ParseHex(blackHex)
+
+	return map[string]any{
+		"textOnShade": map[string]any{
+			"white": roundLc(white.APCAContrast(c)),
+			"black": roundLc(black.APCAContrast(c)),
+		},
+		"shadeOnBackground": map[string]any{
+			"white": roundLc(c.APCAContrast(white)),
+			"black": roundLc(c.APCAContrast(black)),
+		},
+	}
+}
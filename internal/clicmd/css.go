@@ -0,0 +1,94 @@
+package clicmd
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+// writeToCSSFile writes the palettes as a Tailwind CSS v4 @theme block, one
+// --color-<name>-<shade> custom property per shade, each followed by a
+// --color-<name>-<shade>-foreground property for its text color. A
+// foreground taken from the palette refers to that shade's property, unless
+// the palette is translucent: foregrounds are opaque, so they are then
+// written as values.
+func writeToCSSFile(palettes []namedPalette, format ColorFormat, filePath string) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintln(w, "@theme {")
+	for i, p := range palettes {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		opaque := !translucent(p.palette)
+		for _, swatch := range p.palette.Swatches {
+			value, err := cssColor(swatch.Hex, format)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "  --color-%s-%s: %s;\n", p.name, swatch.Name, value)
+
+			if swatch.ForegroundShade != "" && opaque {
+				fmt.Fprintf(w, "  --color-%s-%s-foreground: var(--color-%s-%s);\n", p.name, swatch.Name, p.name, swatch.ForegroundShade)
+			} else if swatch.Foreground != "" {
+				value, err := cssColor(swatch.Foreground, format)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "  --color-%s-%s-foreground: %s;\n", p.name, swatch.Name, value)
+			}
+		}
+	}
+	fmt.Fprintln(w, "}")
+
+	return w.Flush()
+}
+
+// cssColor formats a hex color using modern space-separated CSS syntax,
+// with a "/ alpha" component for translucent colors. HSL values keep one
+// decimal, enough for every color to convert back to the same hex.
+func cssColor(hex string, format ColorFormat) (string, error) {
+	c, err := color.ParseHex(hex)
+	if err != nil {
+		return "", err
+	}
+
+	alpha := ""
+	if c.A != 255 {
+		alpha = " / " + strconv.FormatFloat(roundAlpha(c.Alpha()), 'f', -1, 64)
+	}
+
+	switch format {
+	case HSLFormat:
+		h, s, l := c.HSLExact()
+		return fmt.Sprintf("hsl(%s %s%% %s%%%s)", hslValue(h), hslValue(s*100), hslValue(l*100), alpha), nil
+	case RGBFormat:
+		return fmt.Sprintf("rgb(%d %d %d%s)", c.R, c.G, c.B, alpha), nil
+	case OKLCHFormat:
+		l, ch, h := c.OKLCH()
+		return fmt.Sprintf("oklch(%.1f%% %.3f %.2f%s)", l*100, ch, h, alpha), nil
+	default:
+		return c.Hex(), nil
+	}
+}
+
+// roundAlpha rounds an alpha channel to two decimals, hiding the 8-bit
+// rounding of values such as 0.5.
+// hslValue formats an HSL component with at most one decimal.
+func hslValue(x float64) string {
+	return strconv.FormatFloat(math.Round(x*10)/10, 'f', -1, 64)
+}
+
+func roundAlpha(a float64) float64 {
+	return math.Round(a*100) / 100
+}
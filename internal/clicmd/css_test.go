@@ -0,0 +1,126 @@
+package clicmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestCSSColor(t *testing.T) {
+	tests := map[string]struct {
+		hex    string
+		format ColorFormat
+		want   string
+	}{
+		"Hex":              {hex: "#3b82f6", format: HexFormat, want: "#3B82F6"},
+		"HSL":              {hex: "#3B82F6", format: HSLFormat, want: "hsl(217.2 91.2% 59.8%)"},
+		"HSL whole values": {hex: "#FFFFFF", format: HSLFormat, want: "hsl(0 0% 100%)"},
+		"RGB":              {hex: "#3B82F6", format: RGBFormat, want: "rgb(59 130 246)"},
+		"OKLCH":            {hex: "#3B82F6", format: OKLCHFormat, want: "oklch(62.3% 0.188 259.81)"},
+		"Translucent hex":  {hex: "#3B82F680", format: HexFormat, want: "#3B82F680"},
+		"Translucent HSL":  {hex: "#3B82F680", format: HSLFormat, want: "hsl(217.2 91.2% 59.8% / 0.5)"},
+		"Translucent RGB":  {hex: "#3B82F640", format: RGBFormat, want: "rgb(59 130 246 / 0.25)"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := cssColor(tt.hex, tt.format)
+			if err != nil {
+				t.Fatalf("cssColor() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("cssColor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCSSColorHSLRoundTrip checks that HSL output parses back to the color
+// it was written from, for every shade of a few generated palettes.
+func TestCSSColorHSLRoundTrip(t *testing.T) {
+	for _, base := range []string{"#3B82F6", "#E11D48", "#10B981", "#64748B"} {
+		palette, err := generator.GeneratePalette(base, generator.DefaultTailwindOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, swatch := range palette.Swatches {
+			value, err := cssColor(swatch.Hex, HSLFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := color.Parse(value)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", value, err)
+			}
+			if c.Hex() != swatch.Hex {
+				t.Errorf("%s = %s, parses back to %s, want %s", swatch.Name, value, c.Hex(), swatch.Hex)
+			}
+		}
+	}
+}
+
+func TestWriteToCSSFile(t *testing.T) {
+	tests := map[string]struct {
+		palettes []namedPalette
+		format   ColorFormat
+		want     string
+	}{
+		"Foreground from the palette": {
+			palettes: []namedPalette{{name: "brand", palette: generator.Palette{Base: "#3B82F6", Swatches: []generator.Swatch{
+				{Name: "50", Hex: "#EFF6FF", Foreground: "#1E3A8A", ForegroundShade: "900"},
+				{Name: "900", Hex: "#1E3A8A", Foreground: "#FFFFFF"},
+			}}}},
+			format: HexFormat,
+			want: "@theme {\n" +
+				"  --color-brand-50: #EFF6FF;\n" +
+				"  --color-brand-50-foreground: var(--color-brand-900);\n" +
+				"  --color-brand-900: #1E3A8A;\n" +
+				"  --color-brand-900-foreground: #FFFFFF;\n" +
+				"}\n",
+		},
+		"Translucent palette": {
+			palettes: []namedPalette{{name: "glass", palette: generator.Palette{Base: "#3B82F680", Swatches: []generator.Swatch{
+				{Name: "50", Hex: "#EFF6FF80", Foreground: "#1E3A8A", ForegroundShade: "900"},
+				{Name: "900", Hex: "#1E3A8A80", Foreground: "#FFFFFF"},
+			}}}},
+			format: RGBFormat,
+			want: "@theme {\n" +
+				"  --color-glass-50: rgb(239 246 255 / 0.5);\n" +
+				"  --color-glass-50-foreground: rgb(30 58 138);\n" +
+				"  --color-glass-900: rgb(30 58 138 / 0.5);\n" +
+				"  --color-glass-900-foreground: rgb(255 255 255);\n" +
+				"}\n",
+		},
+		"Several palettes in HSL": {
+			palettes: []namedPalette{
+				{name: "primary", palette: generator.Palette{Swatches: []generator.Swatch{{Name: "500", Hex: "#3B82F6"}}}},
+				{name: "accent", palette: generator.Palette{Swatches: []generator.Swatch{{Name: "500", Hex: "#F97316"}}}},
+			},
+			format: HSLFormat,
+			want: "@theme {\n" +
+				"  --color-primary-500: hsl(217.2 91.2% 59.8%);\n" +
+				"\n" +
+				"  --color-accent-500: hsl(24.6 95% 53.1%);\n" +
+				"}\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "theme.css")
+			if err := writeToCSSFile(tt.palettes, tt.format, path); err != nil {
+				t.Fatalf("writeToCSSFile() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("theme.css =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package clicmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+// collapseDeltaE is the CIEDE2000 difference below which two adjacent
+// shades are hard to tell apart.
+const collapseDeltaE = 2.0
+
+// simulatePalette returns the palette as it appears under a color vision
+// deficiency, with every shade, foreground and the base color simulated.
+func simulatePalette(palette generator.Palette, d color.Deficiency) (generator.Palette, error) {
+	base, err := color.SimulateCVD(palette.Base, d)
+	if err != nil {
+		return generator.Palette{}, err
+	}
+
+	simulated := palette
+	simulated.Base = base
+	simulated.Swatches = make([]generator.Swatch, len(palette.Swatches))
+	for i, swatch := range palette.Swatches {
+		if swatch.Hex, err = color.SimulateCVD(swatch.Hex, d); err != nil {
+			return generator.Palette{}, err
+		}
+		if swatch.Foreground != "" {
+			if swatch.Foreground, err = color.SimulateCVD(swatch.Foreground, d); err != nil {
+				return generator.Palette{}, err
+			}
+		}
+		simulated.Swatches[i] = swatch
+	}
+	return simulated, nil
+}
+
+// collapsedShades returns the adjacent shades of palette that are closer
+// than collapseDeltaE.
+func collapsedShades(palette generator.Palette) ([]shadeStep, error) {
+	steps, err := shadeSteps(palette)
+	if err != nil {
+		return nil, err
+	}
+
+	var collapsed []shadeStep
+	for _, step := range steps {
+		if step.deltaE < collapseDeltaE {
+			collapsed = append(collapsed, step)
+		}
+	}
+	return collapsed, nil
+}
+
+// simulatePalettes simulates every palette under d and warns on w about
+// adjacent shades that collapse together.
+func simulatePalettes(w io.Writer, palettes []namedPalette, d color.Deficiency) ([]namedPalette, error) {
+	simulated := make([]namedPalette, len(palettes))
+	for i, p := range palettes {
+		palette, err := simulatePalette(p.palette, d)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs, err := collapsedShades(palette)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range pairs {
+			fmt.Fprintf(w, "Warning: %s-%s and %s-%s are hard to tell apart under %s (ΔE %.2f)\n",
+				p.name, pair.from, p.name, pair.to, d, pair.deltaE)
+		}
+
+		simulated[i] = namedPalette{name: p.name, palette: palette}
+	}
+	return simulated, nil
+}
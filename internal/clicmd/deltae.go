@@ -0,0 +1,90 @@
+package clicmd
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+// A step is flagged as too close or too far apart when its ΔE is below
+// closeStep or above farStep times the median step of its palette.
+const (
+	closeStep = 0.5
+	farStep   = 1.5
+)
+
+// shadeStep is the CIEDE2000 difference between two consecutive shades.
+type shadeStep struct {
+	from   string
+	to     string
+	deltaE float64
+}
+
+func shadeSteps(palette generator.Palette) ([]shadeStep, error) {
+	var steps []shadeStep
+	for i := 1; i < len(palette.Swatches); i++ {
+		from, to := palette.Swatches[i-1], palette.Swatches[i]
+		deltaE, err := color.DeltaE2000(from.Hex, to.Hex)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, shadeStep{from: from.Name, to: to.Name, deltaE: deltaE})
+	}
+	return steps, nil
+}
+
+// writeShadeSteps prints the ΔE between consecutive shades of every
+// palette, marking steps much smaller or larger than the palette's median.
+func writeShadeSteps(w io.Writer, palettes []namedPalette) error {
+	fmt.Fprintln(w, "\nΔE (CIEDE2000) between consecutive shades:")
+	for _, p := range palettes {
+		steps, err := shadeSteps(p.palette)
+		if err != nil {
+			return err
+		}
+		if len(steps) == 0 {
+			continue
+		}
+
+		indent := "  "
+		if len(palettes) > 1 {
+			fmt.Fprintf(w, "  %s\n", p.name)
+			indent = "    "
+		}
+
+		width := 0
+		for _, step := range steps {
+			width = max(width, len(step.from)+len(step.to)+3)
+		}
+
+		median := medianStep(steps)
+		for _, step := range steps {
+			mark := ""
+			switch {
+			case step.deltaE < median*closeStep:
+				mark = "  too close"
+			case step.deltaE > median*farStep:
+				mark = "  too far"
+			}
+			fmt.Fprintf(w, "%s%-*s  %6.2f%s\n", indent, width, step.from+" → "+step.to, step.deltaE, mark)
+		}
+	}
+	return nil
+}
+
+func medianStep(steps []shadeStep) float64 {
+	values := make([]float64, len(steps))
+	for i, step := range steps {
+		values[i] = step.deltaE
+	}
+	slices.Sort(values)
+
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
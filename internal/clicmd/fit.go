@@ -0,0 +1,400 @@
+package clicmd
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+var (
+	ErrorNoPaletteFound      = errors.New("no palette found: expected palette JSON, CSS custom properties or a list of colors")
+	ErrorSeveralPalettes     = errors.New("input has several palettes: choose one with -n")
+	ErrorUnknownPalette      = errors.New("no palette with that name in the input")
+	ErrorMissingFitArgument  = errors.New("missing palette file argument")
+	ErrorInvalidPaletteShade = errors.New("invalid palette shade: must be a color or an object with a hex value")
+)
+
+// cssColorProperty matches a "--color-<name>-<shade>: <value>;" custom
+// property; the "color-" prefix is optional.
+var cssColorProperty = regexp.MustCompile(`--(?:color-)?([A-Za-z][A-Za-z0-9_-]*)-([A-Za-z0-9]+)\s*:\s*([^;{}]+);`)
+
+// fitPalette is a palette read back for fitting.
+type fitPalette struct {
+	name     string
+	swatches []generator.Swatch
+}
+
+func runFit(args []string) exitCode {
+	flagSet := flag.NewFlagSet("tailwindcss-palette fit", flag.ExitOnError)
+	mode := flagSet.String("mode", string(generator.ModeHSL), "Mode to fit the shade scale in: hsl, oklch or neutral")
+	name := flagSet.String("n", "", "Name of the palette to fit when the input has several")
+	outputFile := flagSet.String("o", "", "Path to output file: a shades file, or theme settings if it ends in .json (default: stdout)")
+
+	flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette fit <palette-file> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Estimates the shade scale that reproduces an existing palette, so new\n")
+		fmt.Fprintf(os.Stderr, "colors can be generated with the same shape. The palette may be JSON\n")
+		fmt.Fprintf(os.Stderr, "written by -o palette.json, CSS --color-<name>-<shade> properties or a\n")
+		fmt.Fprintf(os.Stderr, "list of colors from lightest to darkest. Use - to read standard input.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flagSet.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette fit brand.css -o brand.shades\n")
+		fmt.Fprintf(os.Stderr, "  tailwindcss-palette #E11D48 --shades-file brand.shades\n")
+	}
+
+	for _, arg := range args {
+		if arg == "-h" || arg == "--help" {
+			flagSet.Usage()
+			return exitOK
+		}
+	}
+
+	if len(args) < 1 || (strings.HasPrefix(args[0], "-") && args[0] != "-") {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", ErrorMissingFitArgument)
+		flagSet.Usage()
+		return exitError
+	}
+	inputPath := args[0]
+
+	if err := flagSet.Parse(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+		return exitError
+	}
+
+	var data []byte
+	var err error
+	if inputPath == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(inputPath)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading palette: %v\n", err)
+		return exitError
+	}
+
+	palettes, err := parseFitInput(data)
+	if err != nil {
+		printColorError(err)
+		return exitError
+	}
+	palette, err := choosePalette(palettes, strings.ToLower(*name))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	opts, ref, err := generator.FitOptions(palette.swatches, generator.Mode(strings.ToLower(*mode)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	var output string
+	if strings.EqualFold(filepath.Ext(*outputFile), ".json") {
+		output, err = fitSettingsJSON(opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+	} else {
+		output = fitShadesFile(palette, opts, ref)
+	}
+
+	if *outputFile == "" {
+		fmt.Print(output)
+		return exitOK
+	}
+	if err := os.WriteFile(*outputFile, []byte(output), 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing to file: %v\n", err)
+		return exitError
+	}
+	fmt.Printf("Options fitted to %s have been written to %s\n", palette.name, *outputFile)
+	if tint := fitTintFlag(opts); tint != "" {
+		fmt.Printf("Generating from %s (shade %s) with %sreproduces the palette\n", ref.Hex, ref.Name, tint)
+	} else {
+		fmt.Printf("Generating from %s (shade %s) reproduces the palette\n", ref.Hex, ref.Name)
+	}
+	return exitOK
+}
+
+// fitShadesFile formats fitted options as a commented shades file for
+// --shades-file.
+func fitShadesFile(palette fitPalette, opts generator.Options, ref generator.Swatch) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Shade scale fitted to %s in %s mode.\n", palette.name, opts.Mode())
+	fmt.Fprintf(&b, "# Generate with --mode %s %s--shades-file <this file>; %s (shade %s)\n", opts.Mode(), fitTintFlag(opts), ref.Hex, ref.Name)
+	fmt.Fprintf(&b, "# reproduces the original palette.\n")
+	b.WriteString(generator.FormatShades(opts.Shades()))
+	return b.String()
+}
+
+// fitSettingsJSON formats fitted options as generation settings for a theme
+// config.
+func fitSettingsJSON(opts generator.Options) (string, error) {
+	shades := strings.Split(strings.TrimSpace(generator.FormatShades(opts.Shades())), "\n")
+
+	settings := orderedObject{}
+	settings.set("mode", opts.Mode())
+	settings.set("shades", strings.Join(shades, ","))
+	if opts.Mode() == generator.ModeNeutral {
+		settings.set("tint", opts.Tint())
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// fitTintFlag returns the --tint flag that neutral fits are generated with,
+// or nothing in other modes.
+func fitTintFlag(opts generator.Options) string {
+	if opts.Mode() != generator.ModeNeutral {
+		return ""
+	}
+	return fmt.Sprintf("--tint %g ", opts.Tint())
+}
+
+func choosePalette(palettes []fitPalette, name string) (fitPalette, error) {
+	if name == "" {
+		if len(palettes) > 1 {
+			names := make([]string, len(palettes))
+			for i, p := range palettes {
+				names[i] = p.name
+			}
+			return fitPalette{}, fmt.Errorf("%w (found %s)", ErrorSeveralPalettes, strings.Join(names, ", "))
+		}
+		return palettes[0], nil
+	}
+
+	for _, p := range palettes {
+		if p.name == name {
+			return p, nil
+		}
+	}
+	return fitPalette{}, fmt.Errorf("%w: %q", ErrorUnknownPalette, name)
+}
+
+// parseFitInput reads palettes from JSON, CSS custom properties or a list of
+// colors, whichever the input looks like.
+func parseFitInput(data []byte) ([]fitPalette, error) {
+	trimmed := bytes.TrimSpace(data)
+	var palettes []fitPalette
+	var err error
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		palettes, err = parsePaletteJSON(trimmed)
+	case bytes.Contains(trimmed, []byte("--")):
+		palettes, err = parsePaletteCSS(string(trimmed))
+	default:
+		palettes, err = parseColorList(string(trimmed))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(palettes) == 0 {
+		return nil, ErrorNoPaletteFound
+	}
+	for _, p := range palettes {
+		sortShades(p.swatches)
+	}
+	return palettes, nil
+}
+
+// sortShades orders numerically named shades such as 50 to 950 by number,
+// as JSON objects and CSS do not guarantee their order. Other names keep
+// the order of the input.
+func sortShades(swatches []generator.Swatch) {
+	numbers := make(map[string]float64, len(swatches))
+	for _, swatch := range swatches {
+		n, err := strconv.ParseFloat(swatch.Name, 64)
+		if err != nil {
+			return
+		}
+		numbers[swatch.Name] = n
+	}
+
+	slices.SortStableFunc(swatches, func(a, b generator.Swatch) int {
+		return cmp.Compare(numbers[a.Name], numbers[b.Name])
+	})
+}
+
+// parsePaletteJSON reads the JSON written by writeToJSONFile: a single
+// palette with its base and shades, or such palettes keyed by name. Shades
+// may also be plain color strings, as in Tailwind v3's colors.js.
+func parsePaletteJSON(data []byte) ([]fitPalette, error) {
+	var root orderedObject
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	if _, ok := root.get("palette"); ok {
+		swatches, err := paletteJSONSwatches(root)
+		if err != nil {
+			return nil, err
+		}
+		return []fitPalette{{name: "palette", swatches: swatches}}, nil
+	}
+
+	var palettes []fitPalette
+	for _, member := range root {
+		var entry orderedObject
+		if err := json.Unmarshal(member.value.(json.RawMessage), &entry); err != nil {
+			// Shades keyed by name directly at the top level.
+			swatches, err := shadesJSONSwatches(root)
+			if err != nil {
+				return nil, err
+			}
+			return []fitPalette{{name: "palette", swatches: swatches}}, nil
+		}
+
+		swatches, err := paletteJSONSwatches(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", member.key, err)
+		}
+		palettes = append(palettes, fitPalette{name: strings.ToLower(member.key), swatches: swatches})
+	}
+	return palettes, nil
+}
+
+// paletteJSONSwatches reads the shades of a palette object, which are under
+// "palette" or, for plain objects of colors, the object itself.
+func paletteJSONSwatches(object orderedObject) ([]generator.Swatch, error) {
+	value, ok := object.get("palette")
+	if !ok {
+		return shadesJSONSwatches(object)
+	}
+
+	var shades orderedObject
+	if err := json.Unmarshal(value.(json.RawMessage), &shades); err != nil {
+		return nil, err
+	}
+	return shadesJSONSwatches(shades)
+}
+
+func shadesJSONSwatches(shades orderedObject) ([]generator.Swatch, error) {
+	swatches := make([]generator.Swatch, 0, len(shades))
+	for _, member := range shades {
+		raw := member.value.(json.RawMessage)
+
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			var shade struct {
+				Hex string `json:"hex"`
+			}
+			if err := json.Unmarshal(raw, &shade); err != nil || shade.Hex == "" {
+				return nil, fmt.Errorf("%w, got %s for %q", ErrorInvalidPaletteShade, raw, member.key)
+			}
+			value = shade.Hex
+		}
+
+		swatch, err := fitSwatch(member.key, value)
+		if err != nil {
+			return nil, err
+		}
+		swatches = append(swatches, swatch)
+	}
+	return swatches, nil
+}
+
+// parsePaletteCSS reads --color-<name>-<shade> custom properties, such as
+// those written by writeToCSSFile. Foreground properties are skipped.
+func parsePaletteCSS(css string) ([]fitPalette, error) {
+	var palettes []fitPalette
+	for _, match := range cssColorProperty.FindAllStringSubmatch(css, -1) {
+		name, shade, value := strings.ToLower(match[1]), match[2], strings.TrimSpace(match[3])
+		if shade == "foreground" {
+			continue
+		}
+
+		swatch, err := fitSwatch(shade, value)
+		if err != nil {
+			return nil, err
+		}
+
+		i := slices.IndexFunc(palettes, func(p fitPalette) bool { return p.name == name })
+		if i < 0 {
+			palettes = append(palettes, fitPalette{name: name})
+			i = len(palettes) - 1
+		}
+		palettes[i].swatches = append(palettes[i].swatches, swatch)
+	}
+	return palettes, nil
+}
+
+// parseColorList reads colors separated by newlines, commas or spaces, each
+// optionally written as shade=color. Unnamed colors take the Tailwind shade
+// names when there are eleven of them and are numbered otherwise.
+func parseColorList(list string) ([]fitPalette, error) {
+	entries := splitColorList(list)
+	names := generator.DefaultTailwindOptions().Shades()
+
+	swatches := make([]generator.Swatch, 0, len(entries))
+	for i, entry := range entries {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			value = entry
+			if len(entries) == len(names) {
+				name = names[i].Name()
+			} else {
+				name = strconv.Itoa(i + 1)
+			}
+		}
+
+		swatch, err := fitSwatch(strings.TrimSpace(name), strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		swatches = append(swatches, swatch)
+	}
+
+	if len(swatches) == 0 {
+		return nil, nil
+	}
+	return []fitPalette{{name: "palette", swatches: swatches}}, nil
+}
+
+// splitColorList splits on newlines, commas and spaces outside of
+// parentheses, so functional colors such as rgb(59, 130, 246) stay whole.
+func splitColorList(list string) []string {
+	var entries []string
+	depth, start := 0, 0
+	for i, r := range list + "\n" {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth = max(0, depth-1)
+		case depth == 0 && (r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if entry := strings.TrimSpace(list[start:i]); entry != "" {
+				entries = append(entries, entry)
+			}
+			start = i + 1
+		}
+	}
+	return entries
+}
+
+func fitSwatch(name, value string) (generator.Swatch, error) {
+	c, err := color.Parse(value)
+	if err != nil {
+		return generator.Swatch{}, err
+	}
+	return generator.Swatch{Name: name, Hex: c.Hex()}, nil
+}
@@ -0,0 +1,223 @@
+package clicmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestParseFitInput(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    map[string][]string
+		wantErr error
+	}{
+		"JSON export of one color in map order": {
+			input: `{
+				"base": {"hex": "#3B82F6"},
+				"palette": {
+					"100": {"hex": "#DBEAFE", "foreground": {"hex": "#172554", "shade": "950"}},
+					"50": {"hex": "#EFF6FF"},
+					"950": {"hex": "#172554"},
+					"500": {"hex": "#3B82F6"}
+				}
+			}`,
+			want: map[string][]string{
+				"palette": {"50=#EFF6FF", "100=#DBEAFE", "500=#3B82F6", "950=#172554"},
+			},
+		},
+		"JSON export of several colors": {
+			input: `{
+				"primary": {"base": {"hex": "#3B82F6"}, "palette": {"50": {"hex": "#EFF6FF"}, "500": {"hex": "#3B82F6"}}},
+				"Accent": {"base": {"hex": "#F97316"}, "palette": {"500": {"hex": "#F97316"}, "50": {"hex": "#FFF7ED"}}}
+			}`,
+			want: map[string][]string{
+				"primary": {"50=#EFF6FF", "500=#3B82F6"},
+				"accent":  {"50=#FFF7ED", "500=#F97316"},
+			},
+		},
+		"JSON object of color strings": {
+			input: `{"900": "rgb(30 58 138)", "50": "#eff6ff"}`,
+			want: map[string][]string{
+				"palette": {"50=#EFF6FF", "900=#1E3A8A"},
+			},
+		},
+		"JSON shade without a color": {
+			input:   `{"palette": {"50": {"rgb": {"r": 1}}}}`,
+			wantErr: ErrorInvalidPaletteShade,
+		},
+		"CSS theme": {
+			input: `@theme {
+				--color-brand-500: #3B82F6;
+				--color-brand-500-foreground: var(--color-brand-50);
+				--color-brand-50: oklch(97% 0.014 254.604);
+				--color-brand-50-foreground: #000000;
+				--color-accent-200: rgb(254 215 170);
+			}`,
+			want: map[string][]string{
+				"brand":  {"50=#EFF6FF", "500=#3B82F6"},
+				"accent": {"200=#FED7AA"},
+			},
+		},
+		"CSS without the color prefix": {
+			input: `:root { --brand-100: #DBEAFE; --brand-700: #1D4ED8; }`,
+			want: map[string][]string{
+				"brand": {"100=#DBEAFE", "700=#1D4ED8"},
+			},
+		},
+		"Eleven unnamed colors": {
+			input: "#eff6ff #dbeafe #bfdbfe #93c5fd #60a5fa #3b82f6\n#2563eb,#1d4ed8,#1e40af,#1e3a8a,#172554",
+			want: map[string][]string{
+				"palette": {"50=#EFF6FF", "100=#DBEAFE", "200=#BFDBFE", "300=#93C5FD", "400=#60A5FA", "500=#3B82F6",
+					"600=#2563EB", "700=#1D4ED8", "800=#1E40AF", "900=#1E3A8A", "950=#172554"},
+			},
+		},
+		"Numbered colors keep their order": {
+			input: "rgb(239, 246, 255), hsl(217 91% 60%), #172554",
+			want: map[string][]string{
+				"palette": {"1=#EFF6FF", "2=#3C83F6", "3=#172554"},
+			},
+		},
+		"Named colors": {
+			input: "500=#3B82F6\n50=#EFF6FF\n",
+			want: map[string][]string{
+				"palette": {"50=#EFF6FF", "500=#3B82F6"},
+			},
+		},
+		"Empty input": {
+			input:   "  \n",
+			wantErr: ErrorNoPaletteFound,
+		},
+		"Invalid color": {
+			input:   "#3B82F6 notacolor",
+			wantErr: color.ErrorInvalidColor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			palettes, err := parseFitInput([]byte(tt.input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(palettes) != len(tt.want) {
+				t.Fatalf("got %d palettes, want %d", len(palettes), len(tt.want))
+			}
+			for _, p := range palettes {
+				want, ok := tt.want[p.name]
+				if !ok {
+					t.Fatalf("unexpected palette %q", p.name)
+				}
+				if len(p.swatches) != len(want) {
+					t.Fatalf("%s: got %d shades, want %d", p.name, len(p.swatches), len(want))
+				}
+				for i, swatch := range p.swatches {
+					if got := swatch.Name + "=" + swatch.Hex; got != want[i] {
+						t.Errorf("%s shade %d = %s, want %s", p.name, i, got, want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestChoosePalette(t *testing.T) {
+	palettes := []fitPalette{{name: "primary"}, {name: "accent"}}
+
+	if _, err := choosePalette(palettes, ""); !errors.Is(err, ErrorSeveralPalettes) {
+		t.Errorf("no name: error = %v, want %v", err, ErrorSeveralPalettes)
+	}
+	if p, err := choosePalette(palettes, "accent"); err != nil || p.name != "accent" {
+		t.Errorf("accent: got %q, %v", p.name, err)
+	}
+	if _, err := choosePalette(palettes, "brand"); !errors.Is(err, ErrorUnknownPalette) {
+		t.Errorf("unknown name: error = %v, want %v", err, ErrorUnknownPalette)
+	}
+	if p, err := choosePalette(palettes[:1], ""); err != nil || p.name != "primary" {
+		t.Errorf("single palette: got %q, %v", p.name, err)
+	}
+}
+
+// TestFitExports fits palettes read back from the tool's own exports and
+// checks that generating from the reference shade reproduces them.
+func TestFitExports(t *testing.T) {
+	tests := map[string]struct {
+		export ExportFormat
+		mode   generator.Mode
+		opts   generator.Options
+	}{
+		"JSON in HSL": {
+			export: JSONExport,
+			mode:   generator.ModeHSL,
+			opts:   generator.DefaultTailwindOptions(),
+		},
+		"CSS in OKLCH": {
+			export: CSSExport,
+			mode:   generator.ModeOKLCH,
+			opts:   generator.DefaultPerceptualOptions(),
+		},
+		"CSS in OKLCH with dark custom shades": {
+			export: CSSExport,
+			mode:   generator.ModeOKLCH,
+			opts: generator.NewOptions([]generator.Shade{
+				generator.NewShade("25", 99), generator.NewShade("500", 55),
+				generator.NewShade("950", 18), generator.NewShade("975", 12).WithSaturation(2),
+			}).WithMode(generator.ModeOKLCH),
+		},
+		"CSS in neutral mode": {
+			export: CSSExport,
+			mode:   generator.ModeNeutral,
+			opts:   generator.DefaultNeutralOptions().WithTint(0.8),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			palette, err := generator.GeneratePalette("#0EA5E9", tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join(t.TempDir(), "palette."+string(tt.export))
+			if err := writeOutput([]namedPalette{{name: "sky", palette: palette}}, tt.export, HexFormat, false, path); err != nil {
+				t.Fatal(err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			palettes, err := parseFitInput(data)
+			if err != nil {
+				t.Fatalf("parseFitInput() error = %v", err)
+			}
+			opts, ref, err := generator.FitOptions(palettes[0].swatches, tt.mode)
+			if err != nil {
+				t.Fatalf("FitOptions() error = %v", err)
+			}
+			refitted, err := generator.GeneratePalette(ref.Hex, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, swatch := range refitted.Swatches {
+				want := palette.Swatches[i]
+				d, err := color.DeltaE2000(swatch.Hex, want.Hex)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if swatch.Name != want.Name || d > 1 {
+					t.Errorf("shade %s = %s, want %s %s (ΔE %.2f)", swatch.Name, swatch.Hex, want.Name, want.Hex, d)
+				}
+			}
+		})
+	}
+}
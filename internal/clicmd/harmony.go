@@ -0,0 +1,60 @@
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+// harmonySuffixes name the palettes of harmony colors after their base
+// color, e.g. primary-complement or primary-triadic-1.
+var harmonySuffixes = map[color.Harmony]string{
+	color.Complementary:      "complement",
+	color.Analogous:          "analogous",
+	color.Triadic:            "triadic",
+	color.Tetradic:           "tetradic",
+	color.SplitComplementary: "split",
+}
+
+// harmonyPalettes returns every palette followed by a palette for each color
+// that forms the harmony with its base color, generated with the same
+// options. A harmony palette may not take the name of another palette.
+func harmonyPalettes(palettes []namedPalette, h color.Harmony, opts generator.Options, version tailwind.Version, profile string) ([]namedPalette, error) {
+	seen := make(map[string]bool)
+	for _, p := range palettes {
+		seen[p.name] = true
+	}
+
+	result := make([]namedPalette, 0, len(palettes))
+	for _, p := range palettes {
+		result = append(result, p)
+
+		colors, err := color.HarmonyColors(p.palette.Base, h)
+		if err != nil {
+			return nil, err
+		}
+		for i, value := range colors {
+			name := p.name + "-" + harmonySuffixes[h]
+			if len(colors) > 1 {
+				name = fmt.Sprintf("%s-%d", name, i+1)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%w: %q", ErrorDuplicateName, name)
+			}
+			seen[name] = true
+
+			colorOpts, err := applyProfile(opts, profile, version, value)
+			if err != nil {
+				return nil, err
+			}
+			palette, err := generator.GeneratePalette(value, colorOpts)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			result = append(result, namedPalette{name: name, palette: palette})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package clicmd
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+func TestHarmonyPalettes(t *testing.T) {
+	tests := map[string]struct {
+		names     []string
+		harmony   color.Harmony
+		wantNames []string
+		wantErr   error
+	}{
+		"Complement": {
+			names:     []string{"primary"},
+			harmony:   color.Complementary,
+			wantNames: []string{"primary", "primary-complement"},
+		},
+		"Triadic of several colors": {
+			names:     []string{"primary", "accent"},
+			harmony:   color.Triadic,
+			wantNames: []string{"primary", "primary-triadic-1", "primary-triadic-2", "accent", "accent-triadic-1", "accent-triadic-2"},
+		},
+		"Name taken by a color": {
+			names:   []string{"primary", "primary-complement"},
+			harmony: color.Complementary,
+			wantErr: ErrorDuplicateName,
+		},
+		"Name taken by another harmony palette": {
+			names:   []string{"primary", "primary-triadic-1"},
+			harmony: color.Triadic,
+			wantErr: ErrorDuplicateName,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := generator.DefaultTailwindOptions()
+			palettes := make([]namedPalette, len(tt.names))
+			for i, name := range tt.names {
+				palette, err := generator.GeneratePalette("#3B82F6", opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				palettes[i] = namedPalette{name: name, palette: palette}
+			}
+
+			got, err := harmonyPalettes(palettes, tt.harmony, opts, tailwind.V4, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			names := make([]string, len(got))
+			for i, p := range got {
+				names[i] = p.name
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
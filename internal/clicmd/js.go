@@ -0,0 +1,123 @@
+package clicmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorReservedIdentifier  = errors.New("color name is a reserved word in JavaScript")
+	ErrorDuplicateIdentifier = errors.New("color names map to the same JavaScript identifier")
+)
+
+// jsReservedWords are the names that cannot be declared with const in an ES
+// module or TypeScript file.
+var jsReservedWords = map[string]bool{
+	"arguments": true, "await": true, "break": true, "case": true, "catch": true,
+	"class": true, "const": true, "continue": true, "debugger": true, "default": true,
+	"delete": true, "do": true, "else": true, "enum": true, "eval": true,
+	"export": true, "extends": true, "false": true, "finally": true, "for": true,
+	"function": true, "if": true, "implements": true, "import": true, "in": true,
+	"instanceof": true, "interface": true, "let": true, "new": true, "null": true,
+	"package": true, "private": true, "protected": true, "public": true, "return": true,
+	"static": true, "super": true, "switch": true, "this": true, "throw": true,
+	"true": true, "try": true, "typeof": true, "var": true, "void": true,
+	"while": true, "with": true, "yield": true,
+}
+
+// writeToJSFile writes the palettes as a module that can be spread into
+// theme.extend.colors of a Tailwind CSS v3 config, one object per palette.
+func writeToJSFile(palettes []namedPalette, format ColorFormat, export ExportFormat, filePath string) error {
+	idents, err := jsIdentifiers(palettes)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	for i, p := range palettes {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		ident := idents[i]
+
+		switch export {
+		case CommonJSExport:
+			fmt.Fprintf(w, "const %s = {\n", ident)
+		default:
+			fmt.Fprintf(w, "export const %s = {\n", ident)
+		}
+
+		for _, swatch := range p.palette.Swatches {
+			value, err := cssColor(swatch.Hex, format)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "  %s: '%s',\n", jsKey(swatch.Name), value)
+		}
+
+		if export == TypeScriptExport {
+			fmt.Fprintln(w, "} as const")
+		} else {
+			fmt.Fprintln(w, "}")
+		}
+	}
+
+	if export == CommonJSExport {
+		fmt.Fprintf(w, "\nmodule.exports = { %s }\n", strings.Join(idents, ", "))
+	}
+
+	return w.Flush()
+}
+
+// jsIdentifiers returns the identifier of every palette, rejecting reserved
+// words and names that collide once converted.
+func jsIdentifiers(palettes []namedPalette) ([]string, error) {
+	idents := make([]string, len(palettes))
+	seen := make(map[string]string)
+	for i, p := range palettes {
+		ident := jsIdentifier(p.name)
+		if jsReservedWords[ident] {
+			return nil, fmt.Errorf("%w: %q", ErrorReservedIdentifier, p.name)
+		}
+		if other, ok := seen[ident]; ok {
+			return nil, fmt.Errorf("%w: %q and %q are both %s", ErrorDuplicateIdentifier, other, p.name, ident)
+		}
+		seen[ident] = p.name
+		idents[i] = ident
+	}
+	return idents, nil
+}
+
+// jsIdentifier converts a kebab-case color name to camelCase.
+func jsIdentifier(name string) string {
+	parts := strings.Split(name, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// jsKeyEscaper escapes shade names for a single-quoted string literal.
+var jsKeyEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`)
+
+// jsKey leaves numeric shade names bare, as Tailwind configs usually do, and
+// quotes everything else.
+func jsKey(shade string) string {
+	if _, err := strconv.ParseUint(shade, 10, 32); err == nil && (shade == "0" || shade[0] != '0') {
+		return shade
+	}
+	return "'" + jsKeyEscaper.Replace(shade) + "'"
+}
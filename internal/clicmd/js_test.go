@@ -0,0 +1,160 @@
+package clicmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestJSIdentifiers(t *testing.T) {
+	tests := map[string]struct {
+		names   []string
+		want    []string
+		wantErr error
+	}{
+		"Kebab case to camel case": {
+			names: []string{"primary", "brand-blue", "color-1"},
+			want:  []string{"primary", "brandBlue", "color1"},
+		},
+		"Reserved word": {
+			names:   []string{"default"},
+			wantErr: ErrorReservedIdentifier,
+		},
+		"Reserved word in a list": {
+			names:   []string{"primary", "new"},
+			wantErr: ErrorReservedIdentifier,
+		},
+		"Reserved word only as part of a name": {
+			names: []string{"new-brand"},
+			want:  []string{"newBrand"},
+		},
+		"Collision": {
+			names:   []string{"a-b", "a--b"},
+			wantErr: ErrorDuplicateIdentifier,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			palettes := make([]namedPalette, len(tt.names))
+			for i, n := range tt.names {
+				palettes[i] = namedPalette{name: n}
+			}
+
+			got, err := jsIdentifiers(palettes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSKey(t *testing.T) {
+	tests := map[string]string{
+		"500":   "500",
+		"0":     "0",
+		"050":   "'050'",
+		"2.5":   "'2.5'",
+		"light": "'light'",
+		"it's":  `'it\'s'`,
+		`a\`:    `'a\\'`,
+		`a\'b`:  `'a\\\'b'`,
+	}
+
+	for shade, want := range tests {
+		if got := jsKey(shade); got != want {
+			t.Errorf("jsKey(%q) = %s, want %s", shade, got, want)
+		}
+	}
+}
+
+func TestWriteToJSFile(t *testing.T) {
+	palettes := []namedPalette{
+		{name: "primary", palette: generator.Palette{Base: "#3B82F6", Swatches: []generator.Swatch{
+			{Name: "50", Hex: "#EFF6FF"}, {Name: "dark\\", Hex: "#1E3A8A"},
+		}}},
+		{name: "brand-accent", palette: generator.Palette{Base: "#F97316", Swatches: []generator.Swatch{
+			{Name: "500", Hex: "#F97316"},
+		}}},
+	}
+
+	tests := map[string]struct {
+		export ExportFormat
+		format ColorFormat
+		want   string
+	}{
+		"CommonJS": {
+			export: CommonJSExport,
+			format: HexFormat,
+			want: "const primary = {\n" +
+				"  50: '#EFF6FF',\n" +
+				"  'dark\\\\': '#1E3A8A',\n" +
+				"}\n" +
+				"\n" +
+				"const brandAccent = {\n" +
+				"  500: '#F97316',\n" +
+				"}\n" +
+				"\n" +
+				"module.exports = { primary, brandAccent }\n",
+		},
+		"ESM": {
+			export: ESMExport,
+			format: RGBFormat,
+			want: "export const primary = {\n" +
+				"  50: 'rgb(239 246 255)',\n" +
+				"  'dark\\\\': 'rgb(30 58 138)',\n" +
+				"}\n" +
+				"\n" +
+				"export const brandAccent = {\n" +
+				"  500: 'rgb(249 115 22)',\n" +
+				"}\n",
+		},
+		"TypeScript": {
+			export: TypeScriptExport,
+			format: HexFormat,
+			want: "export const primary = {\n" +
+				"  50: '#EFF6FF',\n" +
+				"  'dark\\\\': '#1E3A8A',\n" +
+				"} as const\n" +
+				"\n" +
+				"export const brandAccent = {\n" +
+				"  500: '#F97316',\n" +
+				"} as const\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "colors.js")
+			if err := writeToJSFile(palettes, tt.format, tt.export, path); err != nil {
+				t.Fatalf("writeToJSFile() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("module =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteToJSFileRejectsReservedWords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "colors.js")
+	palettes := []namedPalette{{name: "default", palette: generator.Palette{Base: "#3B82F6"}}}
+
+	if err := writeToJSFile(palettes, HexFormat, ESMExport, path); !errors.Is(err, ErrorReservedIdentifier) {
+		t.Fatalf("error = %v, want %v", err, ErrorReservedIdentifier)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("%s was written", path)
+	}
+}
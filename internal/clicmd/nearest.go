@@ -0,0 +1,123 @@
+package clicmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+var (
+	ErrorInvalidCount = errors.New("count must be at least 1")
+)
+
+func runNearest(args []string) exitCode {
+	flagSet := flag.NewFlagSet("tailwindcss-palette nearest", flag.ExitOnError)
+	count := flagSet.Int("count", 5, "Number of Tailwind colors to list per color")
+	tailwindVersion := flagSet.String("tailwind", string(tailwind.V4), "Tailwind CSS version whose default colors are searched and referenced, e.g. blue-600: v3 or v4")
+	noColorPtr := flagSet.Bool("no-color", false, "Disable colored output")
+
+	flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette nearest <color>... [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Lists the default Tailwind CSS colors closest to each color by CIEDE2000.\n")
+		fmt.Fprintf(os.Stderr, "Colors may be named as name=color, as with the other commands.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flagSet.PrintDefaults()
+	}
+
+	for _, arg := range args {
+		if arg == "-h" || arg == "--help" {
+			flagSet.Usage()
+			return exitOK
+		}
+	}
+
+	colors, err := parseColorArgs(flagSet, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+		return exitError
+	}
+	if len(colors) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: Missing color argument\n\n")
+		flagSet.Usage()
+		return exitError
+	}
+
+	version, err := parseTailwindVersion(*tailwindVersion)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+	if *count < 1 {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", ErrorInvalidCount)
+		return exitError
+	}
+
+	useColor := !*noColorPtr && isTerminal()
+
+	for i, arg := range colors {
+		name, hex, err := nearestArg(arg, version)
+		if err != nil {
+			printColorError(err)
+			return exitError
+		}
+
+		matches, err := tailwind.Nearest(version, hex, *count)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+
+		if i > 0 {
+			fmt.Println()
+		}
+		if name != "" {
+			fmt.Printf("Nearest Tailwind CSS %s colors to %s (%s):", version, name, hex)
+		} else {
+			fmt.Printf("Nearest Tailwind CSS %s colors to %s:", version, hex)
+		}
+		if useColor {
+			fmt.Printf(" %s", getColorBlock(hex))
+		}
+		fmt.Println()
+
+		width := 0
+		for _, match := range matches {
+			width = max(width, len(match.Ref()))
+		}
+		for _, match := range matches {
+			fmt.Printf("  %-*s  %s  ΔE %.1f", width, match.Ref(), match.Hex, match.DeltaE)
+			if useColor {
+				fmt.Printf("  %s", getColorBlock(match.Hex))
+			}
+			fmt.Println()
+		}
+	}
+
+	return exitOK
+}
+
+// nearestArg parses a color argument, either a bare color or name=color as
+// in the other commands, and returns its name, if any, and opaque hex form.
+func nearestArg(arg string, version tailwind.Version) (name, hex string, err error) {
+	name, value, named := strings.Cut(arg, "=")
+	if !named {
+		name, value = "", arg
+	} else if name = strings.ToLower(name); !colorNamePattern.MatchString(name) {
+		return "", "", fmt.Errorf("%w: %q", ErrorInvalidName, name)
+	}
+
+	value, err = resolveColor(value, version)
+	if err != nil {
+		return "", "", err
+	}
+	c, err := color.Parse(value)
+	if err != nil {
+		return "", "", err
+	}
+	return name, c.Opaque().Hex(), nil
+}
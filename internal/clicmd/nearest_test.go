@@ -0,0 +1,58 @@
+package clicmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+func TestNearestArg(t *testing.T) {
+	tests := map[string]struct {
+		arg      string
+		wantName string
+		wantHex  string
+		wantErr  error
+	}{
+		"Bare color": {
+			arg:     "#3B82F6",
+			wantHex: "#3B82F6",
+		},
+		"Named color": {
+			arg:      "primary=#3b82f6",
+			wantName: "primary",
+			wantHex:  "#3B82F6",
+		},
+		"Name is lowercased": {
+			arg:      "Brand=rgb(59 130 246 / 0.5)",
+			wantName: "brand",
+			wantHex:  "#3B82F6",
+		},
+		"Named Tailwind color": {
+			arg:      "accent=blue-500",
+			wantName: "accent",
+			wantHex:  "#2B7FFF",
+		},
+		"Invalid name": {
+			arg:     "1st=#3B82F6",
+			wantErr: ErrorInvalidName,
+		},
+		"Invalid named color": {
+			arg:     "primary=notacolor",
+			wantErr: color.ErrorInvalidColor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotName, gotHex, err := nearestArg(tt.arg, tailwind.V4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err == nil && (gotName != tt.wantName || gotHex != tt.wantHex) {
+				t.Errorf("got %q %s, want %q %s", gotName, gotHex, tt.wantName, tt.wantHex)
+			}
+		})
+	}
+}
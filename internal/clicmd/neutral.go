@@ -0,0 +1,44 @@
+package clicmd
+
+import (
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+// neutralName is the name of the neutral palette added to exports of
+// several colors.
+const neutralName = "neutral"
+
+// addNeutral appends a neutral palette, tinted with the hue of the first
+// palette's base color, unless a palette is already named neutral. It is
+// generated in neutral mode with the shade scale, profile, tint and
+// foreground of settings, which should be those of the first color.
+func addNeutral(palettes []namedPalette, settings generationSettings) ([]namedPalette, error) {
+	for _, p := range palettes {
+		if p.name == neutralName {
+			return palettes, nil
+		}
+	}
+
+	settings.Mode = string(generator.ModeNeutral)
+	settings.Anchor = ""
+	opts, err := buildOptions(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	base := palettes[0].palette.Base
+	version, err := parseTailwindVersion(settings.Tailwind)
+	if err != nil {
+		return nil, err
+	}
+	opts, err = applyProfile(opts, settings.Profile, version, base)
+	if err != nil {
+		return nil, err
+	}
+
+	palette, err := generator.GeneratePalette(base, opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(palettes, namedPalette{name: neutralName, palette: palette}), nil
+}
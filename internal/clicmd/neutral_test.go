@@ -0,0 +1,78 @@
+package clicmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestAddNeutral(t *testing.T) {
+	primary, err := generator.GeneratePalette("#3B82F6", generator.DefaultTailwindOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	strongTint := 2.0
+	palettes := []namedPalette{{name: "primary", palette: primary}, {name: "accent", palette: primary}}
+
+	tests := map[string]struct {
+		palettes   []namedPalette
+		settings   generationSettings
+		wantShades []string
+		wantErr    error
+	}{
+		"Default scale": {
+			palettes:   palettes,
+			wantShades: []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "950"},
+		},
+		"Custom scale and anchor": {
+			palettes:   palettes,
+			settings:   generationSettings{Mode: "oklch", Shades: "25:99,500:55,975:10", Anchor: "500"},
+			wantShades: []string{"25", "500", "975"},
+		},
+		"Tailwind profile": {
+			palettes:   palettes,
+			settings:   generationSettings{Profile: "slate"},
+			wantShades: []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "950"},
+		},
+		"Palette already named neutral": {
+			palettes: []namedPalette{{name: "primary", palette: primary}, {name: "neutral", palette: primary}},
+		},
+		"Invalid tint": {
+			palettes: palettes,
+			settings: generationSettings{Tint: &strongTint},
+			wantErr:  generator.ErrorInvalidTint,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := addNeutral(tt.palettes, tt.settings)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.wantShades == nil {
+				if len(got) != len(tt.palettes) {
+					t.Fatalf("got %d palettes, want %d", len(got), len(tt.palettes))
+				}
+				return
+			}
+			if len(got) != len(tt.palettes)+1 || got[len(got)-1].name != neutralName {
+				t.Fatalf("got %d palettes, want a neutral palette added", len(got))
+			}
+			neutral := got[len(got)-1].palette
+			if len(neutral.Swatches) != len(tt.wantShades) {
+				t.Fatalf("got %d shades, want %d", len(neutral.Swatches), len(tt.wantShades))
+			}
+			for i, swatch := range neutral.Swatches {
+				if swatch.Name != tt.wantShades[i] {
+					t.Errorf("shade %d = %s, want %s", i, swatch.Name, tt.wantShades[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package clicmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// orderedObject is a JSON object that keeps its keys in insertion order, so
+// shades are written in scale order rather than sorted as strings.
+type orderedObject []objectMember
+
+type objectMember struct {
+	key   string
+	value any
+}
+
+func (o *orderedObject) set(key string, value any) {
+	*o = append(*o, objectMember{key: key, value: value})
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, member := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(member.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(member.value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON reads an object keeping its keys in order. Values are kept
+// as json.RawMessage for the caller to decode.
+func (o *orderedObject) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != json.Delim('{') {
+		return &json.UnmarshalTypeError{Value: "non-object", Type: reflect.TypeOf(o).Elem()}
+	}
+
+	*o = nil
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		o.set(token.(string), value)
+	}
+	return nil
+}
+
+// get returns the value of key and whether it is set.
+func (o orderedObject) get(key string) (any, bool) {
+	for _, member := range o {
+		if member.key == key {
+			return member.value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,59 @@
+package clicmd
+
+import (
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+// parseTailwindVersion parses a Tailwind CSS version such as "v4" or "4".
+// An empty version is v4.
+func parseTailwindVersion(s string) (tailwind.Version, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return tailwind.V4, nil
+	}
+	if !strings.HasPrefix(s, "v") {
+		s = "v" + s
+	}
+	switch version := tailwind.Version(s); version {
+	case tailwind.V3, tailwind.V4:
+		return version, nil
+	default:
+		return "", tailwind.ErrorUnknownVersion
+	}
+}
+
+// resolveColor returns the value of a default Tailwind color reference such
+// as "blue-600" in the given version, or value itself if it is not one.
+func resolveColor(value string, version tailwind.Version) (string, error) {
+	c, found, err := tailwind.Lookup(version, value)
+	if err != nil {
+		return "", err
+	}
+	if found {
+		return c.Value, nil
+	}
+	return value, nil
+}
+
+// applyProfile replaces the shade scale of opts with the profile of a
+// default Tailwind palette, chosen by hue from the color value for
+// tailwind.ProfileAuto. Without a profile, opts is returned unchanged.
+func applyProfile(opts generator.Options, profile string, version tailwind.Version, value string) (generator.Options, error) {
+	if profile == "" {
+		return opts, nil
+	}
+
+	c, err := color.Parse(value)
+	if err != nil {
+		return opts, err
+	}
+	shades, err := tailwind.Profile(version, profile, c.Opaque().Hex(), opts.Mode())
+	if err != nil {
+		return opts, err
+	}
+	return opts.WithShades(shades), nil
+}
@@ -0,0 +1,301 @@
+package clicmd
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+var (
+	ErrorNoThemeColors   = errors.New("theme config must list at least one color")
+	ErrorMissingColor    = errors.New("theme color must have a name and a value")
+	ErrorDuplicateName   = errors.New("duplicate color name")
+	ErrorMissingOutput   = errors.New("theme output must have a path")
+	ErrorMissingArgument = errors.New("missing theme config argument")
+	ErrorOutputIsConfig  = errors.New("theme output would overwrite the config file")
+)
+
+// themeConfig is the JSON file read by the theme command. Generation
+// settings at the top level apply to every color that does not set its own.
+// Neutral set to false leaves out the neutral palette otherwise added to
+// exports of several colors.
+//
+//	{
+//	  "mode": "oklch",
+//	  "colors": [
+//	    { "name": "primary", "value": "#3B82F6", "anchor": "500" },
+//	    { "name": "neutral", "value": "#64748B", "shades": "50:98,500:46,950:4" }
+//	  ],
+//	  "outputs": [
+//	    { "path": "theme.css", "colorFormat": "oklch" },
+//	    { "path": "colors.ts" }
+//	  ]
+//	}
+type themeConfig struct {
+	generationSettings
+	Neutral *bool         `json:"neutral"`
+	Colors  []themeColor  `json:"colors"`
+	Outputs []themeOutput `json:"outputs"`
+}
+
+type themeColor struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	generationSettings
+}
+
+// themeOutput is a file the theme is written to. Paths are relative to the
+// config file; the format defaults to the one implied by the extension.
+// readThemeConfig resolves the path and formats into the unexported fields.
+type themeOutput struct {
+	Path        string `json:"path"`
+	Format      string `json:"format"`
+	ColorFormat string `json:"colorFormat"`
+	APCA        bool   `json:"apca"`
+
+	path        string
+	export      ExportFormat
+	colorFormat ColorFormat
+}
+
+func runTheme(args []string) exitCode {
+	flagSet := flag.NewFlagSet("tailwindcss-palette theme", flag.ExitOnError)
+	colorFormat := flagSet.String("c", string(HexFormat), "Color format for terminal output: hex, hsl, rgb, or oklch")
+	noColorPtr := flagSet.Bool("no-color", false, "Disable colored output")
+
+	flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: tailwindcss-palette theme <config.json> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Generates every color listed in a theme config and writes it to each\n")
+		fmt.Fprintf(os.Stderr, "configured output. Without outputs, the palettes are printed.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flagSet.PrintDefaults()
+	}
+
+	for _, arg := range args {
+		if arg == "-h" || arg == "--help" {
+			flagSet.Usage()
+			return exitOK
+		}
+	}
+
+	if len(args) < 1 || strings.HasPrefix(args[0], "-") {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", ErrorMissingArgument)
+		flagSet.Usage()
+		return exitError
+	}
+
+	configPath := args[0]
+	if err := flagSet.Parse(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+		return exitError
+	}
+
+	format := ColorFormat(strings.ToLower(*colorFormat))
+	if !validColorFormat(format) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", ErrorInvalidFormat)
+		return exitError
+	}
+
+	config, err := readThemeConfig(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading theme config: %v\n", err)
+		return exitError
+	}
+
+	palettes, err := generateTheme(config)
+	if err != nil {
+		printColorError(err)
+		return exitError
+	}
+
+	if len(config.Outputs) == 0 {
+		useColor := !*noColorPtr && isTerminal()
+		if err := outputPalettes(palettes, format, useColor); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		return exitOK
+	}
+
+	if len(config.Colors) > 1 && (config.Neutral == nil || *config.Neutral) {
+		settings := config.generationSettings.merge(config.Colors[0].generationSettings)
+		palettes, err = addNeutral(palettes, settings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+	}
+
+	// JavaScript identifiers depend on the palette names, so check them
+	// before any output is written.
+	for _, output := range config.Outputs {
+		switch output.export {
+		case CommonJSExport, ESMExport, TypeScriptExport:
+			if _, err := jsIdentifiers(palettes); err != nil {
+				fmt.Fprintf(os.Stderr, "Error in output %s: %v\n", output.Path, err)
+				return exitError
+			}
+		}
+	}
+
+	for _, output := range config.Outputs {
+		if err := writeOutput(palettes, output.export, output.colorFormat, output.APCA, output.path); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", output.path, err)
+			return exitError
+		}
+		fmt.Printf("Theme has been written to %s\n", output.path)
+	}
+
+	return exitOK
+}
+
+func readThemeConfig(path string) (themeConfig, error) {
+	var config themeConfig
+
+	file, err := os.Open(path)
+	if err != nil {
+		return config, err
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return config, err
+	}
+
+	if len(config.Colors) == 0 {
+		return config, ErrorNoThemeColors
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range config.Colors {
+		if c.Name == "" || c.Value == "" {
+			return config, ErrorMissingColor
+		}
+		if !colorNamePattern.MatchString(c.Name) {
+			return config, fmt.Errorf("%w: %q", ErrorInvalidName, c.Name)
+		}
+		if seen[c.Name] {
+			return config, fmt.Errorf("%w: %q", ErrorDuplicateName, c.Name)
+		}
+		seen[c.Name] = true
+	}
+
+	for i, output := range config.Outputs {
+		if output.Path == "" {
+			return config, ErrorMissingOutput
+		}
+		if err := resolveThemeOutput(&config.Outputs[i], path); err != nil {
+			return config, fmt.Errorf("output %s: %w", output.Path, err)
+		}
+	}
+
+	return config, nil
+}
+
+// resolveThemeOutput resolves the path of output relative to the config file
+// at configPath and checks its formats, so that no output is written when
+// another one is invalid.
+func resolveThemeOutput(output *themeOutput, configPath string) error {
+	output.path = output.Path
+	if !filepath.IsAbs(output.path) {
+		output.path = filepath.Join(filepath.Dir(configPath), output.path)
+	}
+	if sameFile(output.path, configPath) {
+		return ErrorOutputIsConfig
+	}
+
+	output.export = ExportFormat(strings.ToLower(output.Format))
+	if output.export == "" {
+		output.export = exportFormatFromPath(output.path)
+	}
+	if !validExportFormat(output.export) {
+		return ErrorInvalidExport
+	}
+
+	output.colorFormat = ColorFormat(strings.ToLower(output.ColorFormat))
+	if output.colorFormat == "" {
+		output.colorFormat = HexFormat
+	}
+	if !validColorFormat(output.colorFormat) {
+		return ErrorInvalidFormat
+	}
+	return nil
+}
+
+func generateTheme(config themeConfig) ([]namedPalette, error) {
+	palettes := make([]namedPalette, 0, len(config.Colors))
+	for _, c := range config.Colors {
+		settings := config.generationSettings.merge(c.generationSettings)
+		opts, err := buildOptions(settings)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+
+		version, err := parseTailwindVersion(settings.Tailwind)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+		value, err := resolveColor(c.Value, version)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+
+		opts, err = applyProfile(opts, settings.Profile, version, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+
+		palette, err := generator.GeneratePalette(value, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name, err)
+		}
+		palettes = append(palettes, namedPalette{name: c.Name, palette: palette})
+	}
+	return palettes, nil
+}
+
+func sameFile(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
+
+// merge returns s with every setting that is set in override replaced.
+func (s generationSettings) merge(override generationSettings) generationSettings {
+	if override.Mode != "" {
+		s.Mode = override.Mode
+	}
+	if override.Shades != "" {
+		s.Shades = override.Shades
+	}
+	if override.Anchor != "" {
+		s.Anchor = override.Anchor
+	}
+	if override.HueShift != nil {
+		s.HueShift = override.HueShift
+	}
+	if override.HueShiftDir != "" {
+		s.HueShiftDir = override.HueShiftDir
+	}
+	if override.Foreground != "" {
+		s.Foreground = override.Foreground
+	}
+	if override.Tailwind != "" {
+		s.Tailwind = override.Tailwind
+	}
+	if override.Profile != "" {
+		s.Profile = override.Profile
+	}
+	if override.Tint != nil {
+		s.Tint = override.Tint
+	}
+	return s
+}
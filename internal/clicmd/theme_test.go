@@ -0,0 +1,263 @@
+package clicmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestReadThemeConfig(t *testing.T) {
+	type output struct {
+		path        string
+		export      ExportFormat
+		colorFormat ColorFormat
+	}
+
+	tests := map[string]struct {
+		config      string
+		wantOutputs []output
+		wantErr     error
+	}{
+		"Outputs resolved": {
+			config: `{
+				"colors": [{"name": "primary", "value": "#3B82F6"}],
+				"outputs": [
+					{"path": "theme.css", "colorFormat": "OKLCH"},
+					{"path": "out/colors.ts"},
+					{"path": "brand.tokens.json"},
+					{"path": "tokens.json", "format": "tokens-studio"}
+				]
+			}`,
+			wantOutputs: []output{
+				{"theme.css", CSSExport, OKLCHFormat},
+				{"out/colors.ts", TypeScriptExport, HexFormat},
+				{"brand.tokens.json", DTCGExport, HexFormat},
+				{"tokens.json", TokensStudioExport, HexFormat},
+			},
+		},
+		"No colors": {
+			config:  `{"colors": []}`,
+			wantErr: ErrorNoThemeColors,
+		},
+		"Color without a value": {
+			config:  `{"colors": [{"name": "primary"}]}`,
+			wantErr: ErrorMissingColor,
+		},
+		"Invalid color name": {
+			config:  `{"colors": [{"name": "Primary Blue", "value": "#3B82F6"}]}`,
+			wantErr: ErrorInvalidName,
+		},
+		"Duplicate color name": {
+			config:  `{"colors": [{"name": "primary", "value": "#3B82F6"}, {"name": "primary", "value": "#F97316"}]}`,
+			wantErr: ErrorDuplicateName,
+		},
+		"Output without a path": {
+			config:  `{"colors": [{"name": "primary", "value": "#3B82F6"}], "outputs": [{"format": "css"}]}`,
+			wantErr: ErrorMissingOutput,
+		},
+		"Output overwriting the config": {
+			config: `{"colors": [{"name": "primary", "value": "#3B82F6"}],
+				"outputs": [{"path": "theme.css"}, {"path": "theme.json"}]}`,
+			wantErr: ErrorOutputIsConfig,
+		},
+		"Invalid export format": {
+			config: `{"colors": [{"name": "primary", "value": "#3B82F6"}],
+				"outputs": [{"path": "theme.css"}, {"path": "theme.scss", "format": "scss"}]}`,
+			wantErr: ErrorInvalidExport,
+		},
+		"Invalid color format": {
+			config: `{"colors": [{"name": "primary", "value": "#3B82F6"}],
+				"outputs": [{"path": "theme.css"}, {"path": "colors.ts", "colorFormat": "lab"}]}`,
+			wantErr: ErrorInvalidFormat,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "theme.json")
+			if err := os.WriteFile(path, []byte(tt.config), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := readThemeConfig(path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(config.Outputs) != len(tt.wantOutputs) {
+				t.Fatalf("got %d outputs, want %d", len(config.Outputs), len(tt.wantOutputs))
+			}
+			for i, got := range config.Outputs {
+				want := tt.wantOutputs[i]
+				if wantPath := filepath.Join(dir, want.path); got.path != wantPath {
+					t.Errorf("output %d path = %s, want %s", i, got.path, wantPath)
+				}
+				if got.export != want.export || got.colorFormat != want.colorFormat {
+					t.Errorf("output %d formats = %s, %s, want %s, %s", i, got.export, got.colorFormat, want.export, want.colorFormat)
+				}
+			}
+		})
+	}
+}
+
+func TestRunThemeWritesNothingOnInvalidOutput(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "theme.json")
+	config := `{"colors": [{"name": "primary", "value": "#3B82F6"}],
+		"outputs": [{"path": "theme.css"}, {"path": "colors.ts"}, {"path": "theme.json"}]}`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if code := runTheme([]string{path}); code != exitError {
+		t.Fatalf("runTheme() = %d, want %d", code, exitError)
+	}
+	for _, name := range []string{"theme.css", "colors.ts"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s was written", name)
+		}
+	}
+}
+
+func TestGenerationSettingsMerge(t *testing.T) {
+	shift, otherShift := 10.0, -5.0
+	tint := 0.2
+
+	base := generationSettings{
+		Mode:       "oklch",
+		Shades:     "50:97,500:55",
+		HueShift:   &shift,
+		Foreground: "apca",
+		Tailwind:   "v3",
+		Tint:       &tint,
+	}
+
+	tests := map[string]struct {
+		override generationSettings
+		want     generationSettings
+	}{
+		"Nothing set": {
+			want: base,
+		},
+		"Every setting set": {
+			override: generationSettings{
+				Mode: "hsl", Shades: "100:90", Anchor: "500", HueShift: &otherShift, HueShiftDir: "inverse",
+				Foreground: "wcag:7", Tailwind: "v4", Profile: "blue", Tint: &shift,
+			},
+			want: generationSettings{
+				Mode: "hsl", Shades: "100:90", Anchor: "500", HueShift: &otherShift, HueShiftDir: "inverse",
+				Foreground: "wcag:7", Tailwind: "v4", Profile: "blue", Tint: &shift,
+			},
+		},
+		"Some settings set": {
+			override: generationSettings{Anchor: "auto", Profile: "auto"},
+			want: generationSettings{
+				Mode: "oklch", Shades: "50:97,500:55", Anchor: "auto", HueShift: &shift,
+				Foreground: "apca", Tailwind: "v3", Profile: "auto", Tint: &tint,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := base.merge(tt.override); got != tt.want {
+				t.Errorf("merge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateTheme(t *testing.T) {
+	oklch := generationSettings{Mode: "oklch"}
+
+	tests := map[string]struct {
+		config    themeConfig
+		wantBases map[string]string
+		wantOpts  map[string]generator.Options
+		wantErr   error
+	}{
+		"Top-level settings and overrides": {
+			config: themeConfig{
+				generationSettings: oklch,
+				Colors: []themeColor{
+					{Name: "primary", Value: "#3B82F6"},
+					{Name: "accent", Value: "#F97316", generationSettings: generationSettings{Mode: "hsl"}},
+				},
+			},
+			wantBases: map[string]string{"primary": "#3B82F6", "accent": "#F97316"},
+			wantOpts: map[string]generator.Options{
+				"primary": generator.DefaultPerceptualOptions(),
+				"accent":  generator.DefaultTailwindOptions(),
+			},
+		},
+		"Tailwind color reference in the color's version": {
+			config: themeConfig{
+				Colors: []themeColor{
+					{Name: "v3", Value: "blue-500", generationSettings: generationSettings{Tailwind: "v3"}},
+				},
+			},
+			wantBases: map[string]string{"v3": "#3B82F6"},
+		},
+		"Invalid color": {
+			config: themeConfig{
+				Colors: []themeColor{{Name: "primary", Value: "notacolor"}},
+			},
+			wantErr: color.ErrorInvalidColor,
+		},
+		"Invalid setting of one color": {
+			config: themeConfig{
+				Colors: []themeColor{
+					{Name: "primary", Value: "#3B82F6"},
+					{Name: "accent", Value: "#F97316", generationSettings: generationSettings{Mode: "lab"}},
+				},
+			},
+			wantErr: generator.ErrorInvalidMode,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			palettes, err := generateTheme(tt.config)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(palettes) != len(tt.config.Colors) {
+				t.Fatalf("got %d palettes, want %d", len(palettes), len(tt.config.Colors))
+			}
+			for i, p := range palettes {
+				if p.name != tt.config.Colors[i].Name {
+					t.Errorf("palette %d = %s, want %s", i, p.name, tt.config.Colors[i].Name)
+				}
+				if want := tt.wantBases[p.name]; p.palette.Base != want {
+					t.Errorf("%s base = %s, want %s", p.name, p.palette.Base, want)
+				}
+
+				opts, ok := tt.wantOpts[p.name]
+				if !ok {
+					continue
+				}
+				want, err := generator.GeneratePalette(p.palette.Base, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for j, swatch := range p.palette.Swatches {
+					if swatch.Hex != want.Swatches[j].Hex {
+						t.Errorf("%s shade %s = %s, want %s", p.name, swatch.Name, swatch.Hex, want.Swatches[j].Hex)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package clicmd
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+// tokensStudioSet is the token set Tokens Studio for Figma reads by default.
+const tokensStudioSet = "global"
+
+// writeToTokensFile writes the palettes as W3C Design Tokens Community Group
+// JSON, one group per palette, or in the Tokens Studio for Figma flavour when
+// export is TokensStudioExport. Tokens Studio takes CSS color strings; DTCG
+// values are written by dtcgColor.
+func writeToTokensFile(palettes []namedPalette, format ColorFormat, export ExportFormat, filePath string) error {
+	groups := orderedObject{}
+	for _, p := range palettes {
+		group := orderedObject{}
+		if export == DTCGExport {
+			group.set("$type", "color")
+		}
+
+		for _, swatch := range p.palette.Swatches {
+			if export == TokensStudioExport {
+				value, err := cssColor(swatch.Hex, format)
+				if err != nil {
+					return err
+				}
+				group.set(swatch.Name, map[string]any{"value": value, "type": "color"})
+				continue
+			}
+
+			value, err := dtcgColor(swatch.Hex, format)
+			if err != nil {
+				return err
+			}
+			group.set(swatch.Name, map[string]any{"$value": value})
+		}
+
+		groups.set(p.name, group)
+	}
+
+	var tokens map[string]any
+	if export == TokensStudioExport {
+		tokens = map[string]any{tokensStudioSet: groups}
+	} else {
+		tokens = map[string]any{"color": groups}
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(tokens)
+}
+
+// dtcgColor returns a DTCG color value: a hex string, as in the earlier
+// drafts, for HexFormat, or the object of the 2025.10 format with the
+// components of format's color space and a hex fallback otherwise.
+func dtcgColor(hex string, format ColorFormat) (any, error) {
+	c, err := color.ParseHex(hex)
+	if err != nil {
+		return nil, err
+	}
+
+	value := orderedObject{}
+	switch format {
+	case HSLFormat:
+		h, s, l := c.HSLExact()
+		value.set("colorSpace", "hsl")
+		value.set("components", []float64{roundTo(h, 2), roundTo(s*100, 2), roundTo(l*100, 2)})
+	case RGBFormat:
+		value.set("colorSpace", "srgb")
+		value.set("components", []float64{roundTo(float64(c.R)/255, 4), roundTo(float64(c.G)/255, 4), roundTo(float64(c.B)/255, 4)})
+	case OKLCHFormat:
+		l, ch, h := c.OKLCH()
+		value.set("colorSpace", "oklch")
+		value.set("components", []float64{roundTo(l, 4), roundTo(ch, 4), roundTo(h, 2)})
+	default:
+		return c.Hex(), nil
+	}
+
+	if c.A != 255 {
+		value.set("alpha", roundAlpha(c.Alpha()))
+	}
+	value.set("hex", c.Opaque().Hex())
+	return value, nil
+}
+
+// roundTo rounds x to the given number of decimals.
+func roundTo(x float64, decimals int) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(x*scale) / scale
+}
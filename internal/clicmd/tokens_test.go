@@ -0,0 +1,91 @@
+package clicmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestWriteToTokensFile(t *testing.T) {
+	opaque := generator.Palette{Swatches: []generator.Swatch{{Name: "500", Hex: "#3B82F6"}}}
+	translucent := generator.Palette{Swatches: []generator.Swatch{{Name: "500", Hex: "#3B82F680"}}}
+
+	tests := map[string]struct {
+		palette generator.Palette
+		export  ExportFormat
+		format  ColorFormat
+		want    string
+	}{
+		"DTCG hex": {
+			palette: opaque,
+			export:  DTCGExport,
+			format:  HexFormat,
+			want:    `{"color":{"brand":{"$type":"color","500":{"$value":"#3B82F6"}}}}`,
+		},
+		"DTCG translucent hex": {
+			palette: translucent,
+			export:  DTCGExport,
+			format:  HexFormat,
+			want:    `{"color":{"brand":{"$type":"color","500":{"$value":"#3B82F680"}}}}`,
+		},
+		"DTCG sRGB object": {
+			palette: opaque,
+			export:  DTCGExport,
+			format:  RGBFormat,
+			want: `{"color":{"brand":{"$type":"color","500":{"$value":` +
+				`{"colorSpace":"srgb","components":[0.2314,0.5098,0.9647],"hex":"#3B82F6"}}}}}`,
+		},
+		"DTCG HSL object": {
+			palette: opaque,
+			export:  DTCGExport,
+			format:  HSLFormat,
+			want: `{"color":{"brand":{"$type":"color","500":{"$value":` +
+				`{"colorSpace":"hsl","components":[217.22,91.22,59.8],"hex":"#3B82F6"}}}}}`,
+		},
+		"DTCG translucent OKLCH object": {
+			palette: translucent,
+			export:  DTCGExport,
+			format:  OKLCHFormat,
+			want: `{"color":{"brand":{"$type":"color","500":{"$value":` +
+				`{"colorSpace":"oklch","components":[0.6231,0.188,259.81],"alpha":0.5,"hex":"#3B82F6"}}}}}`,
+		},
+		"Tokens Studio hex": {
+			palette: opaque,
+			export:  TokensStudioExport,
+			format:  HexFormat,
+			want:    `{"global":{"brand":{"500":{"type":"color","value":"#3B82F6"}}}}`,
+		},
+		"Tokens Studio translucent RGB": {
+			palette: translucent,
+			export:  TokensStudioExport,
+			format:  RGBFormat,
+			want:    `{"global":{"brand":{"500":{"type":"color","value":"rgb(59 130 246 / 0.5)"}}}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "brand.tokens.json")
+			palettes := []namedPalette{{name: "brand", palette: tt.palette}}
+			if err := writeToTokensFile(palettes, tt.format, tt.export, path); err != nil {
+				t.Fatalf("writeToTokensFile() error = %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got bytes.Buffer
+			if err := json.Compact(&got, data); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("tokens =\n%s\nwant\n%s", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package color
+
+import (
+	"math"
+)
+
+// APCA-W3 0.0.98G-4g constants, from the WCAG 3 draft's Accessible
+// Perceptual Contrast Algorithm.
+const (
+	apcaMainTRC = 2.4
+
+	apcaBlackThreshold = 0.022
+	apcaBlackClamp     = 1.414
+
+	apcaNormBG  = 0.56
+	apcaNormTXT = 0.57
+	apcaRevTXT  = 0.62
+	apcaRevBG   = 0.65
+
+	apcaScale     = 1.14
+	apcaLowOffset = 0.027
+	apcaLowClip   = 0.1
+	apcaDeltaYMin = 0.0005
+)
+
+// APCAContrast returns the APCA lightness contrast Lc of text in one hex
+// color on a background in another. Lc is about 0 to 106 for dark text on
+// light backgrounds and about 0 to -108 for light text on dark backgrounds.
+// Alpha is ignored.
+func APCAContrast(textHex, backgroundHex string) (float64, error) {
+	text, err := ParseHex(textHex)
+	if err != nil {
+		return 0, err
+	}
+	background, err := ParseHex(backgroundHex)
+	if err != nil {
+		return 0, err
+	}
+	return text.APCAContrast(background), nil
+}
+
+// APCAContrast returns the APCA lightness contrast Lc of col as text on
+// background.
+func (col Color) APCAContrast(background Color) float64 {
+	yText := apcaLuminance(col)
+	yBackground := apcaLuminance(background)
+
+	if math.Abs(yBackground-yText) < apcaDeltaYMin {
+		return 0
+	}
+
+	var lc float64
+	if yBackground > yText {
+		sapc := (math.Pow(yBackground, apcaNormBG) - math.Pow(yText, apcaNormTXT)) * apcaScale
+		if sapc >= apcaLowClip {
+			lc = sapc - apcaLowOffset
+		}
+	} else {
+		sapc := (math.Pow(yBackground, apcaRevBG) - math.Pow(yText, apcaRevTXT)) * apcaScale
+		if sapc <= -apcaLowClip {
+			lc = sapc + apcaLowOffset
+		}
+	}
+	return lc * 100
+}
+
+// apcaLuminance is the APCA screen luminance estimate, with a soft clamp
+// near black.
+func apcaLuminance(col Color) float64 {
+	y := 0.2126729*math.Pow(float64(col.R)/255, apcaMainTRC) +
+		0.7151522*math.Pow(float64(col.G)/255, apcaMainTRC) +
+		0.0721750*math.Pow(float64(col.B)/255, apcaMainTRC)
+	if y < apcaBlackThreshold {
+		y += math.Pow(apcaBlackThreshold-y, apcaBlackClamp)
+	}
+	return y
+}
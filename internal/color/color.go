@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	ErrorInvalidHexFormat = errors.New("invalid hex color format, must be 3 or 6 characters long without prefix")
-	ErrorInvalidHSLValues = errors.New("HSL values must be in the range: 0 <= H < 360, 0 <= S <= 1, 0 <= L <= 1")
+	ErrorInvalidHexFormat      = errors.New("invalid hex color format, must be 3 or 6 characters long without prefix")
+	ErrorInvalidHexAlphaFormat = errors.New("invalid hex color format, must be 3, 4, 6 or 8 characters long without prefix")
+	ErrorInvalidHSLValues      = errors.New("HSL values must be in the range: 0 <= H < 360, 0 <= S <= 1, 0 <= L <= 1")
 )
 
 func HSLToHex(h, s, l float64) (string, error) {
@@ -49,6 +50,13 @@ func HexToHSL(hex string) (h, s, l float64, err error) {
 		return 0, 0, 0, err
 	}
 
+	h, s, l = rgbToHSL(r, g, b)
+	return h, round(s), round(l), nil
+}
+
+// rgbToHSL returns hue in degrees and saturation and lightness in [0, 1],
+// unrounded.
+func rgbToHSL(r, g, b uint8) (h, s, l float64) {
 	Rnot := float64(r) / 255.0
 	Gnot := float64(g) / 255.0
 	Bnot := float64(b) / 255.0
@@ -74,7 +82,7 @@ func HexToHSL(hex string) (h, s, l float64, err error) {
 		s = alpha / (1 - math.Abs(2*l-1))
 	}
 
-	return h, round(s), round(l), nil
+	return h, s, l
 }
 
 func HexToRGB(hex string) (r, g, b uint8, err error) {
@@ -103,6 +111,37 @@ func HexToRGB(hex string) (r, g, b uint8, err error) {
 	return r, g, b, nil
 }
 
+// HexToRGBA is HexToRGB extended to the 4 and 8 digit forms with an alpha
+// channel. Colors without alpha are opaque (a = 255).
+func HexToRGBA(hex string) (r, g, b, a uint8, err error) {
+	digits := strings.Replace(strings.Replace(hex, "0x", "", -1), "#", "", -1)
+	switch utf8.RuneCountInString(digits) {
+	case 4:
+		alpha := digits[3:]
+		r, g, b, err = HexToRGB(digits[:3])
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+		a, err = hex2uint8(alpha + alpha)
+	case 8:
+		r, g, b, err = HexToRGB(digits[:6])
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+		a, err = hex2uint8(digits[6:])
+	default:
+		r, g, b, err = HexToRGB(hex)
+		if err == ErrorInvalidHexFormat {
+			err = ErrorInvalidHexAlphaFormat
+		}
+		a = 255
+	}
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return r, g, b, a, nil
+}
+
 func calculateRGBComponent(p, q, t float64) float64 {
 	if t < 0 {
 		t += 1
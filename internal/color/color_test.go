@@ -113,6 +113,68 @@ func TestHexToRGB(t *testing.T) {
 	}
 }
 
+func TestHexToRGBA(t *testing.T) {
+	tests := []struct {
+		name     string
+		hex      string
+		want     [4]uint8
+		wantErr  bool
+		errValue error
+	}{
+		{
+			name: "Opaque 6 character hex",
+			hex:  "#FF5733",
+			want: [4]uint8{255, 87, 51, 255},
+		},
+		{
+			name: "Opaque 3 character hex",
+			hex:  "F53",
+			want: [4]uint8{255, 85, 51, 255},
+		},
+		{
+			name: "8 character hex",
+			hex:  "#FF573380",
+			want: [4]uint8{255, 87, 51, 128},
+		},
+		{
+			name: "4 character hex",
+			hex:  "#F538",
+			want: [4]uint8{255, 85, 51, 136},
+		},
+		{
+			name:     "Invalid length",
+			hex:      "#FF573",
+			wantErr:  true,
+			errValue: ErrorInvalidHexAlphaFormat,
+		},
+		{
+			name:    "Invalid alpha",
+			hex:     "#FF5733ZZ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, a, err := HexToRGBA(tt.hex)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HexToRGBA() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errValue != nil && err != tt.errValue {
+				t.Errorf("HexToRGBA() error = %v, wantErr %v", err, tt.errValue)
+				return
+			}
+
+			if got := [4]uint8{r, g, b, a}; !tt.wantErr && got != tt.want {
+				t.Errorf("HexToRGBA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestHSLToHex(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,71 @@
+package color
+
+// WCAG 2.x minimum contrast ratios. Large text is at least 18pt, or 14pt
+// bold.
+const (
+	ContrastAALarge  = 3.0
+	ContrastAA       = 4.5
+	ContrastAAALarge = 4.5
+	ContrastAAA      = 7.0
+)
+
+// WCAGRating lists the WCAG 2.x success criteria a contrast ratio passes.
+type WCAGRating struct {
+	AA       bool
+	AALarge  bool
+	AAA      bool
+	AAALarge bool
+}
+
+// RateContrast rates a contrast ratio against the WCAG 2.x thresholds.
+func RateContrast(ratio float64) WCAGRating {
+	return WCAGRating{
+		AA:       ratio >= ContrastAA,
+		AALarge:  ratio >= ContrastAALarge,
+		AAA:      ratio >= ContrastAAA,
+		AAALarge: ratio >= ContrastAAALarge,
+	}
+}
+
+// RelativeLuminance returns the WCAG relative luminance of a hex color in
+// [0, 1]. Alpha is ignored.
+func RelativeLuminance(hex string) (float64, error) {
+	c, err := ParseHex(hex)
+	if err != nil {
+		return 0, err
+	}
+	return c.Luminance(), nil
+}
+
+// ContrastRatio returns the WCAG contrast ratio between two hex colors, from
+// 1 (no contrast) to 21 (black on white). The order of the colors does not
+// matter.
+func ContrastRatio(hex1, hex2 string) (float64, error) {
+	c1, err := ParseHex(hex1)
+	if err != nil {
+		return 0, err
+	}
+	c2, err := ParseHex(hex2)
+	if err != nil {
+		return 0, err
+	}
+	return c1.ContrastRatio(c2), nil
+}
+
+// Luminance returns the WCAG relative luminance of the color in [0, 1].
+// Alpha is ignored.
+func (col Color) Luminance() float64 {
+	r := srgbToLinear(float64(col.R) / 255)
+	g := srgbToLinear(float64(col.G) / 255)
+	b := srgbToLinear(float64(col.B) / 255)
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// ContrastRatio returns the WCAG contrast ratio between col and other.
+func (col Color) ContrastRatio(other Color) float64 {
+	l1, l2 := col.Luminance(), other.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
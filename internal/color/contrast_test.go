@@ -0,0 +1,109 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRelativeLuminance(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    float64
+		wantErr bool
+	}{
+		{name: "White", hex: "#FFFFFF", want: 1},
+		{name: "Black", hex: "#000000", want: 0},
+		{name: "Red", hex: "#FF0000", want: 0.2126},
+		{name: "Mid gray", hex: "#808080", want: 0.2159},
+		{name: "Tailwind blue-500", hex: "#3B82F6", want: 0.2355},
+		{name: "Alpha is ignored", hex: "#3B82F680", want: 0.2355},
+		{name: "Invalid hex", hex: "#ZZ0000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RelativeLuminance(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RelativeLuminance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("RelativeLuminance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		name string
+		hex1 string
+		hex2 string
+		want float64
+	}{
+		{name: "Black on white", hex1: "#000000", hex2: "#FFFFFF", want: 21},
+		{name: "Order does not matter", hex1: "#FFFFFF", hex2: "#000000", want: 21},
+		{name: "Same color", hex1: "#3B82F6", hex2: "#3B82F6", want: 1},
+		{name: "Blue on white", hex1: "#3B82F6", hex2: "#FFFFFF", want: 3.68},
+		{name: "Gray on white", hex1: "#767676", hex2: "#FFFFFF", want: 4.54},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ContrastRatio(tt.hex1, tt.hex2)
+			if err != nil {
+				t.Fatalf("ContrastRatio() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("ContrastRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateContrast(t *testing.T) {
+	tests := []struct {
+		ratio float64
+		want  WCAGRating
+	}{
+		{ratio: 2.9, want: WCAGRating{}},
+		{ratio: 3, want: WCAGRating{AALarge: true}},
+		{ratio: 4.5, want: WCAGRating{AA: true, AALarge: true, AAALarge: true}},
+		{ratio: 7, want: WCAGRating{AA: true, AALarge: true, AAA: true, AAALarge: true}},
+	}
+
+	for _, tt := range tests {
+		if got := RateContrast(tt.ratio); got != tt.want {
+			t.Errorf("RateContrast(%v) = %+v, want %+v", tt.ratio, got, tt.want)
+		}
+	}
+}
+
+func TestAPCAContrast(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		background string
+		want       float64
+	}{
+		{name: "Black on white", text: "#000000", background: "#FFFFFF", want: 106.04},
+		{name: "White on black", text: "#FFFFFF", background: "#000000", want: -107.88},
+		{name: "Gray on white", text: "#888888", background: "#FFFFFF", want: 63.06},
+		{name: "White on gray", text: "#FFFFFF", background: "#888888", want: -68.54},
+		{name: "Same color", text: "#3B82F6", background: "#3B82F6", want: 0},
+		{name: "Below the low clip", text: "#FAFAFA", background: "#FFFFFF", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := APCAContrast(tt.text, tt.background)
+			if err != nil {
+				t.Fatalf("APCAContrast() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("APCAContrast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
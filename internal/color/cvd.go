@@ -0,0 +1,104 @@
+package color
+
+import (
+	"errors"
+	"strings"
+)
+
+// Deficiency is a color vision deficiency that colors can be simulated
+// under.
+type Deficiency string
+
+const (
+	// Protanopia is the absence of long-wavelength (red) cones.
+	Protanopia Deficiency = "protanopia"
+	// Deuteranopia is the absence of medium-wavelength (green) cones.
+	Deuteranopia Deficiency = "deuteranopia"
+	// Tritanopia is the absence of short-wavelength (blue) cones.
+	Tritanopia Deficiency = "tritanopia"
+	// Achromatopsia is total color blindness: only luminance is seen.
+	Achromatopsia Deficiency = "achromatopsia"
+)
+
+var ErrorUnknownDeficiency = errors.New("unknown color vision deficiency: must be one of 'protanopia', 'deuteranopia', 'tritanopia' or 'achromatopsia'")
+
+// Deficiencies lists every supported deficiency.
+var Deficiencies = []Deficiency{Protanopia, Deuteranopia, Tritanopia, Achromatopsia}
+
+// cvdMatrices simulate full dichromacy in linear sRGB, from Machado, Oliveira
+// and Fernandes (2009) at severity 1.
+var cvdMatrices = map[Deficiency]matrix3{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+	// Achromatopsia keeps only the relative luminance.
+	Achromatopsia: {
+		{0.2126, 0.7152, 0.0722},
+		{0.2126, 0.7152, 0.0722},
+		{0.2126, 0.7152, 0.0722},
+	},
+}
+
+var deficiencyAliases = map[string]Deficiency{
+	"protan":  Protanopia,
+	"deutan":  Deuteranopia,
+	"tritan":  Tritanopia,
+	"achroma": Achromatopsia,
+}
+
+// ParseDeficiency parses a deficiency name. The "protan", "deutan", "tritan"
+// and "achroma" abbreviations are accepted too.
+func ParseDeficiency(s string) (Deficiency, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if d, ok := deficiencyAliases[s]; ok {
+		return d, nil
+	}
+	for _, d := range Deficiencies {
+		if s == string(d) {
+			return d, nil
+		}
+	}
+	return "", ErrorUnknownDeficiency
+}
+
+// SimulateCVD returns a hex color as it appears under a color vision
+// deficiency. Alpha is kept.
+func SimulateCVD(hex string, d Deficiency) (string, error) {
+	c, err := ParseHex(hex)
+	if err != nil {
+		return "", err
+	}
+	simulated, err := c.SimulateCVD(d)
+	if err != nil {
+		return "", err
+	}
+	return simulated.Hex(), nil
+}
+
+// SimulateCVD returns the color as it appears under a color vision
+// deficiency. Alpha is kept.
+func (col Color) SimulateCVD(d Deficiency) (Color, error) {
+	m, ok := cvdMatrices[d]
+	if !ok {
+		return Color{}, ErrorUnknownDeficiency
+	}
+
+	r, g, b := m.apply(
+		srgbToLinear(float64(col.R)/255),
+		srgbToLinear(float64(col.G)/255),
+		srgbToLinear(float64(col.B)/255),
+	)
+	return Color{R: linearToUint8(r), G: linearToUint8(g), B: linearToUint8(b), A: col.A}, nil
+}
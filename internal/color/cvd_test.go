@@ -0,0 +1,65 @@
+package color
+
+import (
+	"testing"
+)
+
+func TestSimulateCVD(t *testing.T) {
+	tests := []struct {
+		name       string
+		hex        string
+		deficiency Deficiency
+		want       string
+		wantErr    bool
+	}{
+		{name: "Red under protanopia", hex: "#FF0000", deficiency: Protanopia, want: "#6D5F00"},
+		{name: "Red under deuteranopia", hex: "#FF0000", deficiency: Deuteranopia, want: "#A39000"},
+		{name: "Blue under tritanopia", hex: "#0000FF", deficiency: Tritanopia, want: "#006B96"},
+		{name: "Green under achromatopsia", hex: "#00FF00", deficiency: Achromatopsia, want: "#DCDCDC"},
+		{name: "Tailwind blue-500 under deuteranopia", hex: "#3B82F6", deficiency: Deuteranopia, want: "#007DF4"},
+		{name: "White is unchanged", hex: "#FFFFFF", deficiency: Protanopia, want: "#FFFFFF"},
+		{name: "Gray is unchanged", hex: "#808080", deficiency: Tritanopia, want: "#808080"},
+		{name: "Alpha is kept", hex: "#FF000080", deficiency: Achromatopsia, want: "#7F7F7F80"},
+		{name: "Unknown deficiency", hex: "#FF0000", deficiency: "monochromacy", wantErr: true},
+		{name: "Invalid hex", hex: "#ZZ0000", deficiency: Protanopia, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimulateCVD(tt.hex, tt.deficiency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SimulateCVD() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SimulateCVD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDeficiency(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Deficiency
+		wantErr bool
+	}{
+		{input: "protanopia", want: Protanopia},
+		{input: "Deuteranopia", want: Deuteranopia},
+		{input: "tritan", want: Tritanopia},
+		{input: "deutan", want: Deuteranopia},
+		{input: "achroma", want: Achromatopsia},
+		{input: "protanop", wantErr: true},
+		{input: "monochromacy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDeficiency(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDeficiency(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDeficiency(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
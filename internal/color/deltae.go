@@ -0,0 +1,163 @@
+package color
+
+import (
+	"math"
+)
+
+// DeltaE76 returns the CIE76 color difference between two hex colors: the
+// Euclidean distance in CIELAB. Alpha is ignored.
+func DeltaE76(hex1, hex2 string) (float64, error) {
+	return deltaEHex(hex1, hex2, Color.DeltaE76)
+}
+
+// DeltaE94 returns the CIE94 color difference between two hex colors, with
+// the graphic arts weights. Alpha is ignored.
+func DeltaE94(hex1, hex2 string) (float64, error) {
+	return deltaEHex(hex1, hex2, Color.DeltaE94)
+}
+
+// DeltaE2000 returns the CIEDE2000 color difference between two hex colors.
+// A difference of about 1 is just noticeable, and above 2 is noticeable at
+// a glance. Alpha is ignored.
+func DeltaE2000(hex1, hex2 string) (float64, error) {
+	return deltaEHex(hex1, hex2, Color.DeltaE2000)
+}
+
+func deltaEHex(hex1, hex2 string, deltaE func(Color, Color) float64) (float64, error) {
+	c1, err := ParseHex(hex1)
+	if err != nil {
+		return 0, err
+	}
+	c2, err := ParseHex(hex2)
+	if err != nil {
+		return 0, err
+	}
+	return deltaE(c1, c2), nil
+}
+
+// DeltaE76 returns the CIE76 color difference between col and other.
+func (col Color) DeltaE76(other Color) float64 {
+	l1, a1, b1 := col.Lab()
+	l2, a2, b2 := other.Lab()
+	return deltaE76(l1, a1, b1, l2, a2, b2)
+}
+
+// DeltaE94 returns the CIE94 color difference between col and other, with
+// col as the reference color.
+func (col Color) DeltaE94(other Color) float64 {
+	l1, a1, b1 := col.Lab()
+	l2, a2, b2 := other.Lab()
+	return deltaE94(l1, a1, b1, l2, a2, b2)
+}
+
+// DeltaE2000 returns the CIEDE2000 color difference between col and other.
+func (col Color) DeltaE2000(other Color) float64 {
+	l1, a1, b1 := col.Lab()
+	l2, a2, b2 := other.Lab()
+	return deltaE2000(l1, a1, b1, l2, a2, b2)
+}
+
+func deltaE76(l1, a1, b1, l2, a2, b2 float64) float64 {
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+func deltaE94(l1, a1, b1, l2, a2, b2 float64) float64 {
+	const (
+		k1 = 0.045
+		k2 = 0.015
+	)
+
+	c1 := math.Hypot(a1, b1)
+	c2 := math.Hypot(a2, b2)
+	dl := l1 - l2
+	dc := c1 - c2
+	da := a1 - a2
+	db := b1 - b2
+	dh2 := math.Max(0, da*da+db*db-dc*dc)
+
+	sc := 1 + k1*c1
+	sh := 1 + k2*c1
+	return math.Sqrt(dl*dl + (dc/sc)*(dc/sc) + dh2/(sh*sh))
+}
+
+// deltaE2000 implements CIEDE2000 as given by Sharma, Wu and Dalal (2005),
+// with all parametric weights set to 1.
+func deltaE2000(l1, a1, b1, l2, a2, b2 float64) float64 {
+	const pow25to7 = 6103515625 // 25^7
+
+	cBar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+pow25to7)))
+
+	a1p := a1 * (1 + g)
+	a2p := a2 * (1 + g)
+	c1p := math.Hypot(a1p, b1)
+	c2p := math.Hypot(a2p, b2)
+	h1p := hueAngle(a1p, b1)
+	h2p := hueAngle(a2p, b2)
+
+	dlp := l2 - l1
+	dcp := c2p - c1p
+
+	var dhp float64
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		switch {
+		case dhp > 180:
+			dhp -= 360
+		case dhp < -180:
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(radians(dhp/2))
+
+	lBarp := (l1 + l2) / 2
+	cBarp := (c1p + c2p) / 2
+
+	hBarp := h1p + h2p
+	if c1p*c2p != 0 {
+		switch {
+		case math.Abs(h1p-h2p) <= 180:
+			hBarp /= 2
+		case hBarp < 360:
+			hBarp = (hBarp + 360) / 2
+		default:
+			hBarp = (hBarp - 360) / 2
+		}
+	}
+
+	t := 1 - 0.17*math.Cos(radians(hBarp-30)) +
+		0.24*math.Cos(radians(2*hBarp)) +
+		0.32*math.Cos(radians(3*hBarp+6)) -
+		0.20*math.Cos(radians(4*hBarp-63))
+
+	dTheta := 30 * math.Exp(-((hBarp-275)/25)*((hBarp-275)/25))
+	cBarp7 := math.Pow(cBarp, 7)
+	rc := 2 * math.Sqrt(cBarp7/(cBarp7+pow25to7))
+	lBarp50 := (lBarp - 50) * (lBarp - 50)
+	sl := 1 + 0.015*lBarp50/math.Sqrt(20+lBarp50)
+	sc := 1 + 0.045*cBarp
+	sh := 1 + 0.015*cBarp*t
+	rt := -math.Sin(radians(2*dTheta)) * rc
+
+	dl := dlp / sl
+	dc := dcp / sc
+	dh := dHp / sh
+	return math.Sqrt(dl*dl + dc*dc + dh*dh + rt*dc*dh)
+}
+
+// hueAngle returns the hue angle of a, b in degrees in [0, 360).
+func hueAngle(a, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,115 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHexToLab(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		l, a, b float64
+		wantErr bool
+	}{
+		{name: "White", hex: "#FFFFFF", l: 100, a: 0, b: 0},
+		{name: "Black", hex: "#000000", l: 0, a: 0, b: 0},
+		{name: "Red", hex: "#FF0000", l: 54.29, a: 80.8, b: 69.89},
+		{name: "Tailwind blue-500", hex: "#3B82F6", l: 54.62, a: 8.81, b: -65.8},
+		{name: "Invalid hex", hex: "#ZZ0000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, a, b, err := HexToLab(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HexToLab() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if math.Abs(l-tt.l) > 0.05 || math.Abs(a-tt.a) > 0.05 || math.Abs(b-tt.b) > 0.05 {
+				t.Errorf("HexToLab() = (%.2f, %.2f, %.2f), want (%v, %v, %v)", l, a, b, tt.l, tt.a, tt.b)
+			}
+
+			hex, err := LabToHex(l, a, b)
+			if err != nil {
+				t.Fatalf("LabToHex() error = %v", err)
+			}
+			if hex != tt.hex {
+				t.Errorf("LabToHex() = %v, want %v", hex, tt.hex)
+			}
+		})
+	}
+
+	if _, err := LabToHex(101, 0, 0); err != ErrorInvalidLabValues {
+		t.Errorf("LabToHex(101, 0, 0) error = %v, want %v", err, ErrorInvalidLabValues)
+	}
+}
+
+// Test pairs from Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference
+// Formula: Implementation Notes, Supplementary Test Data, and Mathematical
+// Observations" (2005).
+func TestDeltaE2000Reference(t *testing.T) {
+	tests := []struct {
+		lab1, lab2 [3]float64
+		want       float64
+	}{
+		{lab1: [3]float64{50, 2.6772, -79.7751}, lab2: [3]float64{50, 0, -82.7485}, want: 2.0425},
+		{lab1: [3]float64{50, 3.1571, -77.2803}, lab2: [3]float64{50, 0, -82.7485}, want: 2.8615},
+		{lab1: [3]float64{50, 0, 0}, lab2: [3]float64{50, -1, 2}, want: 2.3669},
+		{lab1: [3]float64{50, -0.001, 2.49}, lab2: [3]float64{50, 0.0009, -2.49}, want: 4.8045},
+		{lab1: [3]float64{50, -0.001, 2.49}, lab2: [3]float64{50, 0.0011, -2.49}, want: 4.7461},
+		{lab1: [3]float64{50, 2.5, 0}, lab2: [3]float64{50, 0, -2.5}, want: 4.3065},
+		{lab1: [3]float64{50, 2.5, 0}, lab2: [3]float64{73, 25, -18}, want: 27.1492},
+		{lab1: [3]float64{50, 2.5, 0}, lab2: [3]float64{56, -27, -3}, want: 31.9030},
+		{lab1: [3]float64{60.2574, -34.0099, 36.2677}, lab2: [3]float64{60.4626, -34.1751, 39.4387}, want: 1.2644},
+		{lab1: [3]float64{22.7233, 20.0904, -46.694}, lab2: [3]float64{23.0331, 14.973, -42.5619}, want: 2.0373},
+		{lab1: [3]float64{90.9257, -0.5406, -0.9208}, lab2: [3]float64{88.6381, -0.8985, -0.7239}, want: 1.5381},
+		{lab1: [3]float64{2.0776, 0.0795, -1.135}, lab2: [3]float64{0.9033, -0.0636, -0.5514}, want: 0.9082},
+	}
+
+	for _, tt := range tests {
+		got := deltaE2000(tt.lab1[0], tt.lab1[1], tt.lab1[2], tt.lab2[0], tt.lab2[1], tt.lab2[2])
+		if math.Abs(got-tt.want) > 0.0001 {
+			t.Errorf("deltaE2000(%v, %v) = %.4f, want %v", tt.lab1, tt.lab2, got, tt.want)
+		}
+		reversed := deltaE2000(tt.lab2[0], tt.lab2[1], tt.lab2[2], tt.lab1[0], tt.lab1[1], tt.lab1[2])
+		if math.Abs(reversed-got) > 1e-9 {
+			t.Errorf("deltaE2000 is not symmetric for %v, %v: %v != %v", tt.lab1, tt.lab2, reversed, got)
+		}
+	}
+}
+
+func TestDeltaE(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex1    string
+		hex2    string
+		deltaE  func(string, string) (float64, error)
+		want    float64
+		wantErr bool
+	}{
+		{name: "CIE76 black and white", hex1: "#000000", hex2: "#FFFFFF", deltaE: DeltaE76, want: 100},
+		{name: "CIE94 black and white", hex1: "#000000", hex2: "#FFFFFF", deltaE: DeltaE94, want: 100},
+		{name: "CIEDE2000 black and white", hex1: "#000000", hex2: "#FFFFFF", deltaE: DeltaE2000, want: 100},
+		{name: "Same color", hex1: "#3B82F6", hex2: "#3B82F6", deltaE: DeltaE2000, want: 0},
+		{name: "Alpha is ignored", hex1: "#3B82F680", hex2: "#3B82F6", deltaE: DeltaE2000, want: 0},
+		{name: "CIE76 red and orange", hex1: "#FF0000", hex2: "#FF8000", deltaE: DeltaE76, want: 38.14},
+		{name: "CIE94 red and orange", hex1: "#FF0000", hex2: "#FF8000", deltaE: DeltaE94, want: 18.08},
+		{name: "CIEDE2000 red and orange", hex1: "#FF0000", hex2: "#FF8000", deltaE: DeltaE2000, want: 19.66},
+		{name: "Invalid hex", hex1: "#ZZ0000", hex2: "#FFFFFF", deltaE: DeltaE2000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.deltaE(tt.hex1, tt.hex2)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("got %.4f, want %v", got, tt.want)
+			}
+		})
+	}
+}
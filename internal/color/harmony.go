@@ -0,0 +1,132 @@
+package color
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// Harmony is a color scheme built by rotating a color's hue.
+type Harmony string
+
+const (
+	// Complementary is the color opposite on the hue wheel.
+	Complementary Harmony = "complementary"
+	// Analogous are the two neighbors 30° to either side.
+	Analogous Harmony = "analogous"
+	// Triadic are the two colors 120° apart from it and each other.
+	Triadic Harmony = "triadic"
+	// Tetradic completes a rectangle on the hue wheel, at 60°, 180° and
+	// 240°.
+	Tetradic Harmony = "tetradic"
+	// SplitComplementary are the two neighbors of the complement, at 150°
+	// and 210°.
+	SplitComplementary Harmony = "split-complementary"
+)
+
+var ErrorUnknownHarmony = errors.New("unknown color harmony: must be one of 'complementary', 'analogous', 'triadic', 'tetradic' or 'split-complementary'")
+
+// Harmonies lists every supported harmony.
+var Harmonies = []Harmony{Complementary, Analogous, Triadic, Tetradic, SplitComplementary}
+
+// harmonyAngles are the hue rotations of each harmony, in degrees.
+var harmonyAngles = map[Harmony][]float64{
+	Complementary:      {180},
+	Analogous:          {-30, 30},
+	Triadic:            {120, 240},
+	Tetradic:           {60, 180, 240},
+	SplitComplementary: {150, 210},
+}
+
+var harmonyAliases = map[string]Harmony{
+	"complement": Complementary,
+	"split":      SplitComplementary,
+}
+
+// ParseHarmony parses a harmony name. The "complement" and "split"
+// abbreviations are accepted too.
+func ParseHarmony(s string) (Harmony, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if h, ok := harmonyAliases[s]; ok {
+		return h, nil
+	}
+	for _, h := range Harmonies {
+		if s == string(h) {
+			return h, nil
+		}
+	}
+	return "", ErrorUnknownHarmony
+}
+
+// HarmonyColors returns the colors that form a harmony with a hex color,
+// not including the color itself. See Color.Harmony.
+func HarmonyColors(hex string, h Harmony) ([]string, error) {
+	c, err := ParseHex(hex)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := c.Harmony(h)
+	if err != nil {
+		return nil, err
+	}
+
+	hexes := make([]string, len(colors))
+	for i, c := range colors {
+		hexes[i] = c.Hex()
+	}
+	return hexes, nil
+}
+
+// Complement returns the complementary color of a hex color.
+func Complement(hex string) (string, error) {
+	colors, err := HarmonyColors(hex, Complementary)
+	if err != nil {
+		return "", err
+	}
+	return colors[0], nil
+}
+
+// AnalogousColors returns the two analogous colors of a hex color.
+func AnalogousColors(hex string) ([]string, error) {
+	return HarmonyColors(hex, Analogous)
+}
+
+// TriadicColors returns the two other colors of a hex color's triad.
+func TriadicColors(hex string) ([]string, error) {
+	return HarmonyColors(hex, Triadic)
+}
+
+// TetradicColors returns the three other colors of a hex color's tetrad.
+func TetradicColors(hex string) ([]string, error) {
+	return HarmonyColors(hex, Tetradic)
+}
+
+// SplitComplementaryColors returns the two split complements of a hex
+// color.
+func SplitComplementaryColors(hex string) ([]string, error) {
+	return HarmonyColors(hex, SplitComplementary)
+}
+
+// Harmony returns the colors that form a harmony with the color, not
+// including the color itself. Hues are rotated in OKLCH, so the colors keep
+// the perceived lightness and chroma of the original; chroma is reduced
+// where a rotated hue would leave the sRGB gamut. Alpha is kept.
+func (col Color) Harmony(h Harmony) ([]Color, error) {
+	angles, ok := harmonyAngles[h]
+	if !ok {
+		return nil, ErrorUnknownHarmony
+	}
+
+	l, c, hue := col.OKLCH()
+	colors := make([]Color, len(angles))
+	for i, angle := range angles {
+		rotated := math.Mod(hue+angle+360, 360)
+		rc, err := FromOKLCH(l, math.Min(c, MaxChroma(l, rotated)), rotated)
+		if err != nil {
+			return nil, err
+		}
+		rc.A = col.A
+		colors[i] = rc
+	}
+	return colors, nil
+}
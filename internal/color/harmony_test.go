@@ -0,0 +1,83 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHarmonyColors(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		harmony Harmony
+		want    []string
+		angles  []float64
+		wantErr bool
+	}{
+		{name: "Complementary", hex: "#3B82F6", harmony: Complementary, want: []string{"#B07D00"}, angles: []float64{180}},
+		{name: "Analogous", hex: "#3B82F6", harmony: Analogous, want: []string{"#0094C3", "#886CEE"}, angles: []float64{-30, 30}},
+		{name: "Triadic", hex: "#3B82F6", harmony: Triadic, want: []string{"#E24956", "#3BA01B"}, angles: []float64{120, 240}},
+		{name: "Tetradic", hex: "#3B82F6", harmony: Tetradic, angles: []float64{60, 180, 240}},
+		{name: "Split complementary", hex: "#3B82F6", harmony: SplitComplementary, angles: []float64{150, 210}},
+		{name: "Gray stays gray", hex: "#808080", harmony: Triadic, want: []string{"#808080", "#808080"}},
+		{name: "Alpha is kept", hex: "#3B82F680", harmony: Complementary, want: []string{"#B07D0080"}},
+		{name: "Unknown harmony", hex: "#3B82F6", harmony: "monochromatic", wantErr: true},
+		{name: "Invalid hex", hex: "#ZZ0000", harmony: Triadic, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HarmonyColors(tt.hex, tt.harmony)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HarmonyColors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			for i, want := range tt.want {
+				if got[i] != want {
+					t.Errorf("color %d = %s, want %s", i, got[i], want)
+				}
+			}
+
+			// Harmony colors keep the lightness of the base color and
+			// rotate its OKLCH hue.
+			l, _, h, _ := HexToOKLCH(tt.hex[:7])
+			for i, angle := range tt.angles {
+				gl, _, gh, _ := HexToOKLCH(got[i])
+				if math.Abs(gl-l) > 0.005 {
+					t.Errorf("color %d lightness = %.3f, want %.3f", i, gl, l)
+				}
+				if d := math.Abs(math.Mod(gh-h-angle+720, 360)); math.Min(d, 360-d) > 1 {
+					t.Errorf("color %d hue = %.1f, want %.1f", i, gh, math.Mod(h+angle+360, 360))
+				}
+			}
+		})
+	}
+}
+
+func TestParseHarmony(t *testing.T) {
+	tests := map[string]struct {
+		want    Harmony
+		wantErr error
+	}{
+		"triadic":              {want: Triadic},
+		" Split-Complementary": {want: SplitComplementary},
+		"complement":           {want: Complementary},
+		"split":                {want: SplitComplementary},
+		"square":               {wantErr: ErrorUnknownHarmony},
+	}
+
+	for input, tt := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := ParseHarmony(input)
+			if err != tt.wantErr {
+				t.Fatalf("ParseHarmony() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseHarmony() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
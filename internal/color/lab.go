@@ -0,0 +1,69 @@
+package color
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrorInvalidLabValues = errors.New("Lab values must be in the range: 0 <= L <= 100")
+)
+
+var (
+	linearSRGBToXYZD65 = matrix3{
+		{0.41239079926595934, 0.357584339383878, 0.1804807884018343},
+		{0.21263900587151027, 0.715168678767756, 0.07219231536073371},
+		{0.01933081871559182, 0.11919477979462598, 0.9505321522496607},
+	}
+	xyzD65ToD50 = matrix3{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+)
+
+// HexToLab converts a hex color to CIELAB relative to D50, the same Lab that
+// CSS lab() uses. L is in [0, 100].
+func HexToLab(hex string) (l, a, b float64, err error) {
+	r, g, bl, err := HexToRGB(hex)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	l, a, b = xyzD50ToLab(xyzD65ToD50.apply(linearSRGBToXYZD65.apply(
+		srgbToLinear(float64(r)/255.0),
+		srgbToLinear(float64(g)/255.0),
+		srgbToLinear(float64(bl)/255.0),
+	)))
+	return l, a, b, nil
+}
+
+// LabToHex converts a D50 CIELAB color to hex. Colors outside the sRGB
+// gamut are clipped per channel.
+func LabToHex(l, a, b float64) (string, error) {
+	if l < 0 || l > 100 {
+		return "", ErrorInvalidLabValues
+	}
+	return srgbToColor(xyzD65ToSRGB(xyzD50ToD65.apply(labToXYZD50(l, a, b)))).Hex(), nil
+}
+
+// Lab returns the color in D50 CIELAB. Alpha is ignored.
+func (col Color) Lab() (l, a, b float64) {
+	l, a, b, _ = HexToLab(col.Opaque().Hex())
+	return l, a, b
+}
+
+// xyzD50ToLab converts XYZ relative to D50 to CIELAB.
+func xyzD50ToLab(x, y, z float64) (l, a, b float64) {
+	fx := labF(x / d50White[0])
+	fy := labF(y / d50White[1])
+	fz := labF(z / d50White[2])
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+func labF(t float64) float64 {
+	if t > labEpsilon {
+		return math.Cbrt(t)
+	}
+	return (labKappa*t + 16) / 116
+}
@@ -0,0 +1,85 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// Color is an 8-bit sRGB color with an alpha channel, where A = 255 is
+// fully opaque.
+type Color struct {
+	R, G, B, A uint8
+}
+
+// ParseHex parses a hex color in the 3, 4, 6 or 8 digit form.
+func ParseHex(hex string) (Color, error) {
+	r, g, b, a, err := HexToRGBA(hex)
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{R: r, G: g, B: b, A: a}, nil
+}
+
+func FromHSL(h, s, l float64) (Color, error) {
+	hex, err := HSLToHex(h, s, l)
+	if err != nil {
+		return Color{}, err
+	}
+	return ParseHex(hex)
+}
+
+func FromOKLCH(l, c, h float64) (Color, error) {
+	hex, err := OKLCHToHex(l, c, h)
+	if err != nil {
+		return Color{}, err
+	}
+	return ParseHex(hex)
+}
+
+// Hex returns the color as an uppercase #RRGGBB string, or #RRGGBBAA when
+// it is not fully opaque.
+func (col Color) Hex() string {
+	hex, _ := RGBToHex(col.R, col.G, col.B)
+	if col.A != 255 {
+		hex += fmt.Sprintf("%02X", col.A)
+	}
+	return hex
+}
+
+// Alpha returns the alpha channel in [0, 1].
+func (col Color) Alpha() float64 {
+	return float64(col.A) / 255
+}
+
+// WithAlpha returns the color with its alpha channel set to a in [0, 1].
+func (col Color) WithAlpha(a float64) Color {
+	col.A = uint8(math.Round(math.Max(0, math.Min(1, a)) * 255))
+	return col
+}
+
+// Opaque returns the color with full alpha.
+func (col Color) Opaque() Color {
+	col.A = 255
+	return col
+}
+
+func (col Color) HSL() (h, s, l float64) {
+	h, s, l, _ = HexToHSL(col.Opaque().Hex())
+	return h, s, l
+}
+
+// HSLExact returns the color's HSL without the rounding of saturation and
+// lightness done by HSL, so it converts back to the same color.
+func (col Color) HSLExact() (h, s, l float64) {
+	return rgbToHSL(col.R, col.G, col.B)
+}
+
+func (col Color) OKLab() (l, a, b float64) {
+	l, a, b, _ = HexToOKLab(col.Opaque().Hex())
+	return l, a, b
+}
+
+func (col Color) OKLCH() (l, c, h float64) {
+	l, c, h, _ = HexToOKLCH(col.Opaque().Hex())
+	return l, c, h
+}
@@ -0,0 +1,120 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    Color
+		wantHex string
+		wantErr bool
+	}{
+		{
+			name:    "6 character hex",
+			hex:     "#3b82f6",
+			want:    Color{R: 59, G: 130, B: 246, A: 255},
+			wantHex: "#3B82F6",
+		},
+		{
+			name:    "3 character hex without #",
+			hex:     "F53",
+			want:    Color{R: 255, G: 85, B: 51, A: 255},
+			wantHex: "#FF5533",
+		},
+		{
+			name:    "8 character hex with alpha",
+			hex:     "#3B82F680",
+			want:    Color{R: 59, G: 130, B: 246, A: 128},
+			wantHex: "#3B82F680",
+		},
+		{
+			name:    "4 character hex with alpha",
+			hex:     "#F538",
+			want:    Color{R: 255, G: 85, B: 51, A: 136},
+			wantHex: "#FF553388",
+		},
+		{
+			name:    "Opaque alpha is dropped from hex",
+			hex:     "#3B82F6FF",
+			want:    Color{R: 59, G: 130, B: 246, A: 255},
+			wantHex: "#3B82F6",
+		},
+		{
+			name:    "Invalid hex",
+			hex:     "#ZZ00FF",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid length",
+			hex:     "#3B82F",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseHex() = %+v, want %+v", got, tt.want)
+			}
+			if got.Hex() != tt.wantHex {
+				t.Errorf("Hex() = %v, want %v", got.Hex(), tt.wantHex)
+			}
+		})
+	}
+}
+
+func TestColorConversions(t *testing.T) {
+	c, err := FromHSL(0, 1, 0.5)
+	if err != nil {
+		t.Fatalf("FromHSL() error = %v", err)
+	}
+	if c != (Color{R: 255, A: 255}) {
+		t.Errorf("FromHSL() = %+v, want red", c)
+	}
+
+	if h, s, l := c.HSL(); h != 0 || s != 1 || l != 0.5 {
+		t.Errorf("HSL() = (%v, %v, %v), want (0, 1, 0.5)", h, s, l)
+	}
+
+	l, ch, h := c.OKLCH()
+	back, err := FromOKLCH(l, ch, h)
+	if err != nil {
+		t.Fatalf("FromOKLCH() error = %v", err)
+	}
+	if back != c {
+		t.Errorf("FromOKLCH(OKLCH()) = %+v, want %+v", back, c)
+	}
+}
+
+func TestColorAlpha(t *testing.T) {
+	c := Color{R: 59, G: 130, B: 246, A: 255}.WithAlpha(0.5)
+	if c.A != 128 {
+		t.Errorf("WithAlpha(0.5).A = %v, want 128", c.A)
+	}
+	if math.Abs(c.Alpha()-0.502) > 0.001 {
+		t.Errorf("Alpha() = %v, want 0.502", c.Alpha())
+	}
+	if c.Opaque().Hex() != "#3B82F6" {
+		t.Errorf("Opaque().Hex() = %v, want #3B82F6", c.Opaque().Hex())
+	}
+
+	// Conversions ignore alpha.
+	if h, s, l := c.HSL(); math.Round(h) != 217 || s != 0.91 || l != 0.6 {
+		t.Errorf("HSL() = (%v, %v, %v), want (217, 0.91, 0.6)", h, s, l)
+	}
+	if h, s, l := c.HSLExact(); math.Abs(h-217.22) > 0.01 || math.Abs(s-0.9122) > 0.0001 || math.Abs(l-0.598) > 0.0001 {
+		t.Errorf("HSLExact() = (%v, %v, %v), want (217.22, 0.9122, 0.598)", h, s, l)
+	}
+}
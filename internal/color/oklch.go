@@ -0,0 +1,142 @@
+package color
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrorInvalidOKLCHValues = errors.New("OKLCH values must be in the range: 0 <= L <= 1, C >= 0, 0 <= H < 360")
+)
+
+// achromaticChroma is the chroma below which a color is treated as gray and
+// its hue reported as 0, since the hue angle of near-zero a/b is just noise.
+const achromaticChroma = 1e-4
+
+func HexToOKLab(hex string) (l, a, b float64, err error) {
+	r, g, bl, err := HexToRGB(hex)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	l, a, b = linearRGBToOKLab(
+		srgbToLinear(float64(r)/255.0),
+		srgbToLinear(float64(g)/255.0),
+		srgbToLinear(float64(bl)/255.0),
+	)
+	return l, a, b, nil
+}
+
+// OKLabToHex converts an OKLab color to hex. Colors outside the sRGB gamut
+// are clipped per channel; use OKLCHToHex with a reduced chroma to keep hue.
+func OKLabToHex(l, a, b float64) (string, error) {
+	if l < 0 || l > 1 {
+		return "", ErrorInvalidOKLCHValues
+	}
+
+	r, g, bl := okLabToLinearRGB(l, a, b)
+	return RGBToHex(linearToUint8(r), linearToUint8(g), linearToUint8(bl))
+}
+
+func HexToOKLCH(hex string) (l, c, h float64, err error) {
+	l, a, b, err := HexToOKLab(hex)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	c, h = okLabToLCH(a, b)
+	return l, c, h, nil
+}
+
+func OKLCHToHex(l, c, h float64) (string, error) {
+	if l < 0 || l > 1 || c < 0 || h < 0 || h >= 360 {
+		return "", ErrorInvalidOKLCHValues
+	}
+
+	a, b := okLCHToLab(c, h)
+	return OKLabToHex(l, a, b)
+}
+
+func okLabToLCH(a, b float64) (c, h float64) {
+	c = math.Hypot(a, b)
+	if c < achromaticChroma {
+		return c, 0
+	}
+
+	h = math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return c, h
+}
+
+func okLCHToLab(c, h float64) (a, b float64) {
+	rad := h * math.Pi / 180
+	return c * math.Cos(rad), c * math.Sin(rad)
+}
+
+func linearRGBToOKLab(r, g, b float64) (L, A, B float64) {
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	L = 0.2104542553*l + 0.7936177850*m - 0.0040720468*s
+	A = 1.9779984951*l - 2.4285922050*m + 0.4505937099*s
+	B = 0.0259040371*l + 0.7827717662*m - 0.8086757660*s
+	return L, A, B
+}
+
+func okLabToLinearRGB(L, A, B float64) (r, g, b float64) {
+	l := L + 0.3963377774*A + 0.2158037573*B
+	m := L - 0.1055613458*A - 0.0638541728*B
+	s := L - 0.0894841775*A - 1.2914855480*B
+
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	r = 4.0767416621*l - 3.3077115913*m + 0.2309699292*s
+	g = -1.2684380046*l + 2.6097574011*m - 0.3413193965*s
+	b = -0.0041960863*l - 0.7034186147*m + 1.7076147010*s
+	return r, g, b
+}
+
+// srgbToLinear removes the sRGB transfer function from a channel in [0, 1].
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB applies the sRGB transfer function to a linear channel in [0, 1].
+func linearToSRGB(c float64) float64 {
+	if c <= 0.0031308 {
+		return 12.92 * c
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+func linearToUint8(c float64) uint8 {
+	c = math.Max(0, math.Min(1, c))
+	return uint8(math.Round(linearToSRGB(c) * 255))
+}
+
+// MaxChroma returns the largest chroma at lightness l and hue h that still
+// fits in the sRGB gamut.
+func MaxChroma(l, h float64) float64 {
+	lo, hi := 0.0, 0.4
+	for range 24 {
+		mid := (lo + hi) / 2
+		a, b := okLCHToLab(mid, h)
+		if inGamut(okLabToLinearRGB(l, a, b)) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+func inGamut(r, g, b float64) bool {
+	const eps = 1e-6
+	return r >= -eps && r <= 1+eps && g >= -eps && g <= 1+eps && b >= -eps && b <= 1+eps
+}
@@ -0,0 +1,214 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHexToOKLCH(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		wantL   float64
+		wantC   float64
+		wantH   float64
+		wantErr bool
+	}{
+		{
+			name:  "Red",
+			hex:   "#FF0000",
+			wantL: 0.628,
+			wantC: 0.258,
+			wantH: 29.23,
+		},
+		{
+			name:  "Tailwind blue-500",
+			hex:   "#3B82F6",
+			wantL: 0.623,
+			wantC: 0.188,
+			wantH: 259.81,
+		},
+		{
+			name:  "White",
+			hex:   "#FFFFFF",
+			wantL: 1,
+			wantC: 0,
+			wantH: 0,
+		},
+		{
+			name:  "Black",
+			hex:   "#000000",
+			wantL: 0,
+			wantC: 0,
+			wantH: 0,
+		},
+		{
+			name:  "Gray has no hue",
+			hex:   "#808080",
+			wantL: 0.600,
+			wantC: 0,
+			wantH: 0,
+		},
+		{
+			name:    "Invalid hex",
+			hex:     "#ZZ00FF",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, c, h, err := HexToOKLCH(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HexToOKLCH() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if math.Abs(l-tt.wantL) > 0.001 || math.Abs(c-tt.wantC) > 0.001 || math.Abs(h-tt.wantH) > 0.01 {
+				t.Errorf("HexToOKLCH() = (%v, %v, %v), want (%v, %v, %v)",
+					l, c, h, tt.wantL, tt.wantC, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestOKLCHToHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		l       float64
+		c       float64
+		h       float64
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Tailwind blue-500",
+			l:    0.623,
+			c:    0.188,
+			h:    259.81,
+			want: "#3B82F6",
+		},
+		{
+			name: "White",
+			l:    1,
+			c:    0,
+			h:    0,
+			want: "#FFFFFF",
+		},
+		{
+			name: "Black",
+			l:    0,
+			c:    0,
+			h:    0,
+			want: "#000000",
+		},
+		{
+			name: "Out of gamut is clipped",
+			l:    0.5,
+			c:    0.4,
+			h:    140,
+			want: "#008900",
+		},
+		{
+			name:    "Invalid lightness",
+			l:       1.5,
+			c:       0.1,
+			h:       120,
+			wantErr: true,
+		},
+		{
+			name:    "Negative chroma",
+			l:       0.5,
+			c:       -0.1,
+			h:       120,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid hue",
+			l:       0.5,
+			c:       0.1,
+			h:       360,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OKLCHToHex(tt.l, tt.c, tt.h)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OKLCHToHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("OKLCHToHex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOKLabRoundtrip(t *testing.T) {
+	hexes := []string{"#FF0000", "#00FF00", "#0000FF", "#1A2B3C", "#F5F8FE", "#000713"}
+
+	for _, hex := range hexes {
+		t.Run(hex, func(t *testing.T) {
+			l, a, b, err := HexToOKLab(hex)
+			if err != nil {
+				t.Errorf("HexToOKLab() error = %v", err)
+				return
+			}
+
+			got, err := OKLabToHex(l, a, b)
+			if err != nil {
+				t.Errorf("OKLabToHex() error = %v", err)
+				return
+			}
+
+			if got != hex {
+				t.Errorf("Roundtrip conversion failed: original = %v, result = %v", hex, got)
+			}
+		})
+	}
+}
+
+func TestSRGBLinearization(t *testing.T) {
+	for i := 0; i <= 255; i++ {
+		c := float64(i) / 255.0
+		if got := linearToUint8(srgbToLinear(c)); int(got) != i {
+			t.Errorf("linearToUint8(srgbToLinear(%d/255)) = %d", i, got)
+		}
+	}
+}
+
+func TestMaxChroma(t *testing.T) {
+	tests := []struct {
+		name string
+		l    float64
+		h    float64
+	}{
+		{name: "Light blue", l: 0.97, h: 260},
+		{name: "Mid blue", l: 0.62, h: 260},
+		{name: "Dark red", l: 0.25, h: 25},
+		{name: "Light yellow", l: 0.95, h: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := MaxChroma(tt.l, tt.h)
+			if c <= 0 {
+				t.Fatalf("MaxChroma() = %v, want > 0", c)
+			}
+
+			a, b := okLCHToLab(c, tt.h)
+			if !inGamut(okLabToLinearRGB(tt.l, a, b)) {
+				t.Errorf("MaxChroma() = %v is outside the sRGB gamut", c)
+			}
+
+			a, b = okLCHToLab(c+0.005, tt.h)
+			if inGamut(okLabToLinearRGB(tt.l, a, b)) {
+				t.Errorf("MaxChroma() = %v is not the gamut boundary", c)
+			}
+		})
+	}
+}
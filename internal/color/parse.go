@@ -0,0 +1,602 @@
+package color
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorInvalidColor = errors.New("invalid color")
+)
+
+// ParseError reports a color string that could not be parsed, pointing at
+// the offending token.
+type ParseError struct {
+	// Input is the whole color string.
+	Input string
+	// Offset is the byte offset of the offending token in Input.
+	Offset int
+	// Token is the offending token, empty at the end of the input.
+	Token string
+	// Reason describes what was wrong.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid color %q at offset %d: %s", e.Input, e.Offset, e.Reason)
+}
+
+// Unwrap makes every ParseError match ErrorInvalidColor.
+func (e *ParseError) Unwrap() error {
+	return ErrorInvalidColor
+}
+
+// Parse parses any CSS Color Level 4 color: hex (with or without "#", in the
+// 3, 4, 6 and 8 digit forms), named colors, rgb(), hsl(), hwb(), lab(),
+// lch(), oklab(), oklch() and color() in both the legacy comma-separated and
+// the modern space-separated syntax, with an optional alpha. Colors outside
+// the sRGB gamut are clipped.
+func Parse(s string) (Color, error) {
+	p := &parser{input: s}
+	return p.parse()
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenFunction
+	tokenHash
+	tokenNumber
+	tokenPercentage
+	tokenDimension
+	tokenComma
+	tokenSlash
+	tokenCloseParen
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+	// value is the numeric value of number, percentage and dimension tokens.
+	value float64
+	// unit is the lowercase unit of dimension tokens.
+	unit string
+}
+
+// name returns the lowercase name of ident and function tokens.
+func (t token) name() string {
+	return strings.ToLower(strings.TrimSuffix(t.text, "("))
+}
+
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of input"
+	}
+	return strconv.Quote(t.text)
+}
+
+type parser struct {
+	input  string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return &ParseError{
+		Input:  p.input,
+		Offset: t.offset,
+		Token:  t.text,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) parse() (Color, error) {
+	trimmed := strings.TrimSpace(p.input)
+	if trimmed == "" {
+		return Color{}, &ParseError{Input: p.input, Reason: "empty color"}
+	}
+
+	lower := strings.ToLower(trimmed)
+	if rgb, ok := namedColors[lower]; ok {
+		return Color{R: rgb[0], G: rgb[1], B: rgb[2], A: 255}, nil
+	}
+	if lower == "transparent" {
+		return Color{}, nil
+	}
+	if isBareHex(lower) {
+		return ParseHex(trimmed)
+	}
+
+	if err := p.lex(); err != nil {
+		return Color{}, err
+	}
+
+	var c Color
+	var err error
+
+	t := p.next()
+	switch t.kind {
+	case tokenHash:
+		c, err = ParseHex(t.text)
+		if err != nil {
+			return Color{}, p.errorf(t, "expected 3, 4, 6 or 8 hex digits, got %q", t.text)
+		}
+	case tokenIdent:
+		return Color{}, p.errorf(t, "unknown color name %q", t.text)
+	case tokenFunction:
+		c, err = p.parseFunction(t)
+		if err != nil {
+			return Color{}, err
+		}
+	default:
+		return Color{}, p.errorf(t, "expected a color, got %s", t.describe())
+	}
+
+	if t := p.next(); t.kind != tokenEOF {
+		return Color{}, p.errorf(t, "unexpected %s after color", t.describe())
+	}
+
+	return c, nil
+}
+
+// isBareHex reports whether s is a hex color without "#", optionally with a
+// "0x" prefix.
+func isBareHex(s string) bool {
+	s = strings.TrimPrefix(s, "0x")
+	if len(s) != 3 && len(s) != 4 && len(s) != 6 && len(s) != 8 {
+		return false
+	}
+	for _, r := range s {
+		if !isHexDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexDigit(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'
+}
+
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b == '_'
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func (p *parser) lex() error {
+	s := p.input
+	i := 0
+	for i < len(s) {
+		start := i
+		ch := s[i]
+
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\f':
+			i++
+			continue
+		case ch == ',':
+			i++
+			p.tokens = append(p.tokens, token{kind: tokenComma, text: ",", offset: start})
+		case ch == '/':
+			i++
+			p.tokens = append(p.tokens, token{kind: tokenSlash, text: "/", offset: start})
+		case ch == ')':
+			i++
+			p.tokens = append(p.tokens, token{kind: tokenCloseParen, text: ")", offset: start})
+		case ch == '#':
+			i++
+			for i < len(s) && (isLetter(s[i]) || isDigit(s[i])) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenHash, text: s[start:i], offset: start})
+		case startsNumber(s[i:]):
+			t, err := p.lexNumber(start)
+			if err != nil {
+				return err
+			}
+			i += len(t.text)
+			p.tokens = append(p.tokens, t)
+		case isLetter(ch) || ch == '-' && i+1 < len(s) && (isLetter(s[i+1]) || s[i+1] == '-'):
+			i++
+			for i < len(s) && (isLetter(s[i]) || isDigit(s[i]) || s[i] == '-') {
+				i++
+			}
+			kind := tokenIdent
+			if i < len(s) && s[i] == '(' {
+				i++
+				kind = tokenFunction
+			}
+			p.tokens = append(p.tokens, token{kind: kind, text: s[start:i], offset: start})
+		default:
+			text := s[start:]
+			if r := []rune(text); len(r) > 0 {
+				text = string(r[0])
+			}
+			return p.errorf(token{text: text, offset: start}, "unexpected character %q", text)
+		}
+	}
+
+	p.tokens = append(p.tokens, token{kind: tokenEOF, offset: len(s)})
+	return nil
+}
+
+func startsNumber(s string) bool {
+	if s[0] == '+' || s[0] == '-' {
+		s = s[1:]
+	}
+	if s == "" {
+		return false
+	}
+	return isDigit(s[0]) || s[0] == '.' && len(s) > 1 && isDigit(s[1])
+}
+
+func (p *parser) lexNumber(start int) (token, error) {
+	s := p.input
+	i := start
+	if s[i] == '+' || s[i] == '-' {
+		i++
+	}
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+		i++
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+
+	value, err := strconv.ParseFloat(s[start:i], 64)
+	if err != nil {
+		return token{}, p.errorf(token{text: s[start:i], offset: start}, "invalid number %q", s[start:i])
+	}
+
+	t := token{kind: tokenNumber, offset: start, value: value}
+	switch {
+	case i < len(s) && s[i] == '%':
+		i++
+		t.kind = tokenPercentage
+	case i < len(s) && isLetter(s[i]):
+		unitStart := i
+		for i < len(s) && isLetter(s[i]) {
+			i++
+		}
+		t.kind = tokenDimension
+		t.unit = strings.ToLower(s[unitStart:i])
+	}
+	t.text = s[start:i]
+
+	return t, nil
+}
+
+// colorArgs are the arguments of a color function.
+type colorArgs struct {
+	values []token
+	alpha  *token
+	legacy bool
+}
+
+// parseArgs parses count channel values followed by an optional alpha and
+// the closing parenthesis. Legacy comma-separated syntax is accepted only
+// when allowLegacy is set.
+func (p *parser) parseArgs(fn token, count int, allowLegacy bool) (colorArgs, error) {
+	var args colorArgs
+
+	for i := 0; i < count; i++ {
+		if i == 1 && p.peek().kind == tokenComma {
+			comma := p.next()
+			if !allowLegacy {
+				return args, p.errorf(comma, "commas are not allowed in %s)", fn.name()+"(")
+			}
+			args.legacy = true
+		} else if i > 1 && args.legacy {
+			if t := p.next(); t.kind != tokenComma {
+				return args, p.errorf(t, "expected \",\", got %s", t.describe())
+			}
+		}
+
+		t, err := p.parseValue(args.legacy)
+		if err != nil {
+			return args, err
+		}
+		args.values = append(args.values, t)
+	}
+
+	if first := args.values[0]; args.legacy && first.kind == tokenIdent {
+		return args, p.errorf(first, "\"none\" is not allowed in the legacy comma-separated syntax")
+	}
+
+	separator := tokenSlash
+	if args.legacy {
+		separator = tokenComma
+	}
+	if p.peek().kind == separator {
+		p.next()
+		t, err := p.parseValue(args.legacy)
+		if err != nil {
+			return args, err
+		}
+		args.alpha = &t
+	}
+
+	switch t := p.next(); t.kind {
+	case tokenCloseParen:
+		return args, nil
+	case tokenEOF:
+		return args, p.errorf(t, "missing \")\" to close %s", fn.text)
+	default:
+		return args, p.errorf(t, "expected \")\", got %s", t.describe())
+	}
+}
+
+func (p *parser) parseValue(legacy bool) (token, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber, tokenPercentage, tokenDimension:
+		return t, nil
+	case tokenIdent:
+		if t.name() == "none" {
+			if legacy {
+				return t, p.errorf(t, "\"none\" is not allowed in the legacy comma-separated syntax")
+			}
+			return t, nil
+		}
+	}
+	return t, p.errorf(t, "expected a number, percentage or \"none\", got %s", t.describe())
+}
+
+// number returns the value of a channel token. Percentages are scaled so
+// that 100% equals percentRef; a percentRef of 0 rejects percentages.
+func (p *parser) number(t token, percentRef float64) (float64, error) {
+	switch t.kind {
+	case tokenNumber:
+		return t.value, nil
+	case tokenPercentage:
+		if percentRef == 0 {
+			return 0, p.errorf(t, "percentages are not allowed here, got %q", t.text)
+		}
+		return t.value / 100 * percentRef, nil
+	case tokenDimension:
+		return 0, p.errorf(t, "unexpected unit %q in %q", t.unit, t.text)
+	default:
+		return 0, nil
+	}
+}
+
+// hue returns a hue token in degrees, normalized to [0, 360).
+func (p *parser) hue(t token) (float64, error) {
+	var deg float64
+	switch t.kind {
+	case tokenNumber:
+		deg = t.value
+	case tokenDimension:
+		switch t.unit {
+		case "deg":
+			deg = t.value
+		case "grad":
+			deg = t.value * 0.9
+		case "rad":
+			deg = t.value * 180 / math.Pi
+		case "turn":
+			deg = t.value * 360
+		default:
+			return 0, p.errorf(t, "unknown angle unit %q in %q", t.unit, t.text)
+		}
+	case tokenPercentage:
+		return 0, p.errorf(t, "expected a hue angle, got %q", t.text)
+	}
+
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	if deg >= 360 {
+		deg = 0
+	}
+	return deg, nil
+}
+
+// alpha returns the alpha of a color function in [0, 1], defaulting to
+// opaque.
+func (p *parser) alpha(args colorArgs) (float64, error) {
+	if args.alpha == nil {
+		return 1, nil
+	}
+
+	a, err := p.number(*args.alpha, 1)
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(0, math.Min(1, a)), nil
+}
+
+func (p *parser) parseFunction(fn token) (Color, error) {
+	name := fn.name()
+	switch name {
+	case "rgb", "rgba":
+		return p.parseRGB(fn)
+	case "hsl", "hsla", "hwb":
+		return p.parseHueFunction(fn)
+	case "lab", "lch", "oklab", "oklch":
+		return p.parseLab(fn)
+	case "color":
+		return p.parseColorFunction(fn)
+	default:
+		return Color{}, p.errorf(fn, "unknown color function %q", name)
+	}
+}
+
+func (p *parser) parseRGB(fn token) (Color, error) {
+	args, err := p.parseArgs(fn, 3, true)
+	if err != nil {
+		return Color{}, err
+	}
+
+	var channels [3]float64
+	for i, t := range args.values {
+		if args.legacy && t.kind != args.values[0].kind {
+			return Color{}, p.errorf(t, "cannot mix numbers and percentages in the legacy comma-separated syntax, got %q", t.text)
+		}
+		v, err := p.number(t, 255)
+		if err != nil {
+			return Color{}, err
+		}
+		channels[i] = v / 255
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return Color{}, err
+	}
+	return srgbToColor(channels[0], channels[1], channels[2]).WithAlpha(alpha), nil
+}
+
+func (p *parser) parseHueFunction(fn token) (Color, error) {
+	name := fn.name()
+	args, err := p.parseArgs(fn, 3, name != "hwb")
+	if err != nil {
+		return Color{}, err
+	}
+
+	h, err := p.hue(args.values[0])
+	if err != nil {
+		return Color{}, err
+	}
+
+	var channels [2]float64
+	for i, t := range args.values[1:] {
+		if args.legacy && t.kind != tokenPercentage {
+			return Color{}, p.errorf(t, "expected a percentage, got %q", t.text)
+		}
+		v, err := p.number(t, 100)
+		if err != nil {
+			return Color{}, err
+		}
+		channels[i] = math.Max(0, math.Min(100, v)) / 100
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return Color{}, err
+	}
+
+	if name == "hwb" {
+		return srgbToColor(hwbToSRGB(h, channels[0], channels[1])).WithAlpha(alpha), nil
+	}
+	return srgbToColor(hslToSRGB(h, channels[0], channels[1])).WithAlpha(alpha), nil
+}
+
+func (p *parser) parseLab(fn token) (Color, error) {
+	name := fn.name()
+	args, err := p.parseArgs(fn, 3, false)
+	if err != nil {
+		return Color{}, err
+	}
+
+	// Percentage references from CSS Color 4: lab() and lch() lightness is
+	// 0-100, oklab() and oklch() lightness 0-1.
+	lightnessRef, abRef, chromaRef := 100.0, 125.0, 150.0
+	if strings.HasPrefix(name, "ok") {
+		lightnessRef, abRef, chromaRef = 1, 0.4, 0.4
+	}
+
+	l, err := p.number(args.values[0], lightnessRef)
+	if err != nil {
+		return Color{}, err
+	}
+	l = math.Max(0, math.Min(lightnessRef, l))
+
+	var a, b float64
+	if name == "lab" || name == "oklab" {
+		if a, err = p.number(args.values[1], abRef); err != nil {
+			return Color{}, err
+		}
+		if b, err = p.number(args.values[2], abRef); err != nil {
+			return Color{}, err
+		}
+	} else {
+		c, err := p.number(args.values[1], chromaRef)
+		if err != nil {
+			return Color{}, err
+		}
+		h, err := p.hue(args.values[2])
+		if err != nil {
+			return Color{}, err
+		}
+		a, b = okLCHToLab(math.Max(0, c), h)
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return Color{}, err
+	}
+
+	if strings.HasPrefix(name, "ok") {
+		r, g, bl := okLabToLinearRGB(l, a, b)
+		return srgbToColor(signedLinearToSRGB(r), signedLinearToSRGB(g), signedLinearToSRGB(bl)).WithAlpha(alpha), nil
+	}
+	return srgbToColor(xyzD65ToSRGB(xyzD50ToD65.apply(labToXYZD50(l, a, b)))).WithAlpha(alpha), nil
+}
+
+func (p *parser) parseColorFunction(fn token) (Color, error) {
+	space := p.next()
+	if space.kind != tokenIdent {
+		return Color{}, p.errorf(space, "expected a color space, got %s", space.describe())
+	}
+	if _, _, _, ok := predefinedToSRGB(space.name(), 0, 0, 0); !ok {
+		return Color{}, p.errorf(space, "unknown color space %q", space.text)
+	}
+
+	args, err := p.parseArgs(fn, 3, false)
+	if err != nil {
+		return Color{}, err
+	}
+
+	var channels [3]float64
+	for i, t := range args.values {
+		if channels[i], err = p.number(t, 1); err != nil {
+			return Color{}, err
+		}
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return Color{}, err
+	}
+
+	r, g, b, _ := predefinedToSRGB(space.name(), channels[0], channels[1], channels[2])
+	return srgbToColor(r, g, b).WithAlpha(alpha), nil
+}
@@ -0,0 +1,125 @@
+package color
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "Hex", input: "#3b82f6", want: "#3B82F6"},
+		{name: "Short hex", input: "#38F", want: "#3388FF"},
+		{name: "Hex without #", input: "3B82F6", want: "#3B82F6"},
+		{name: "Hex with 0x", input: "0x3b82f6", want: "#3B82F6"},
+		{name: "Surrounding whitespace", input: "  #3B82F6\n", want: "#3B82F6"},
+		{name: "Named color", input: "cornflowerblue", want: "#6495ED"},
+		{name: "Named color is case insensitive", input: "RebeccaPurple", want: "#663399"},
+		{name: "rgb space separated", input: "rgb(59 130 246)", want: "#3B82F6"},
+		{name: "rgb legacy", input: "rgb(59, 130, 246)", want: "#3B82F6"},
+		{name: "rgba legacy percentages", input: "rgba(23.1%, 51%, 96.5%, 1)", want: "#3B82F6"},
+		{name: "rgb mixed numbers and percentages", input: "rgb(59 51% 246)", want: "#3B82F6"},
+		{name: "rgb none", input: "rgb(none 130 246)", want: "#0082F6"},
+		{name: "rgb clips out of range channels", input: "rgb(300 -20 128)", want: "#FF0080"},
+		{name: "rgb opaque alpha", input: "rgb(59 130 246 / 100%)", want: "#3B82F6"},
+		{name: "Hex with alpha", input: "#3B82F680", want: "#3B82F680"},
+		{name: "Short hex with alpha", input: "#38F8", want: "#3388FF88"},
+		{name: "Hex with alpha without #", input: "3b82f680", want: "#3B82F680"},
+		{name: "rgb alpha percentage", input: "rgb(59 130 246 / 50%)", want: "#3B82F680"},
+		{name: "rgb alpha number", input: "rgb(59 130 246 / 0.25)", want: "#3B82F640"},
+		{name: "rgba legacy alpha", input: "rgba(59, 130, 246, 0.5)", want: "#3B82F680"},
+		{name: "hsl alpha", input: "hsl(0 100% 50% / 0.5)", want: "#FF000080"},
+		{name: "oklch alpha", input: "oklch(0.623 0.188 259.8 / 20%)", want: "#3B82F633"},
+		{name: "color alpha", input: "color(srgb 1 0 0 / 0.5)", want: "#FF000080"},
+		{name: "Alpha none", input: "rgb(59 130 246 / none)", want: "#3B82F600"},
+		{name: "Alpha is clamped", input: "rgb(59 130 246 / 1.5)", want: "#3B82F6"},
+		{name: "Transparent", input: "transparent", want: "#00000000"},
+		{name: "Uppercase function", input: "RGB(59 130 246)", want: "#3B82F6"},
+		{name: "hsl space separated", input: "hsl(0 100% 50%)", want: "#FF0000"},
+		{name: "hsl legacy", input: "hsla(120, 100%, 25%, 1)", want: "#008000"},
+		{name: "hsl numbers", input: "hsl(240 100 50)", want: "#0000FF"},
+		{name: "hsl deg", input: "hsl(120deg 100% 50%)", want: "#00FF00"},
+		{name: "hsl turn", input: "hsl(0.5turn 100% 50%)", want: "#00FFFF"},
+		{name: "hsl rad", input: "hsl(3.14159rad 100% 50%)", want: "#00FFFF"},
+		{name: "hsl grad", input: "hsl(200grad 100% 50%)", want: "#00FFFF"},
+		{name: "hsl negative hue", input: "hsl(-120 100% 50%)", want: "#0000FF"},
+		{name: "hwb", input: "hwb(0 0% 0%)", want: "#FF0000"},
+		{name: "hwb gray", input: "hwb(90 60% 60%)", want: "#808080"},
+		{name: "oklch", input: "oklch(0.623 0.188 259.8)", want: "#3B82F6"},
+		{name: "oklch percentage lightness", input: "oklch(62.3% 0.188 259.8)", want: "#3B82F6"},
+		{name: "oklab", input: "oklab(0.628 0.2249 0.1258)", want: "#FF0000"},
+		{name: "lab white", input: "lab(100 0 0)", want: "#FFFFFF"},
+		{name: "lab red", input: "lab(54.29 80.8 69.89)", want: "#FF0000"},
+		{name: "lch red", input: "lch(54.29 106.84 40.85)", want: "#FF0000"},
+		{name: "color srgb", input: "color(srgb 1 0 0.5)", want: "#FF0080"},
+		{name: "color srgb-linear", input: "color(srgb-linear 1 0 0.21586)", want: "#FF0080"},
+		{name: "color display-p3 gray", input: "color(display-p3 0.5 0.5 0.5)", want: "#808080"},
+		{name: "color display-p3 red is clipped", input: "color(display-p3 1 0 0)", want: "#FF0000"},
+		{name: "color xyz white", input: "color(xyz 0.9505 1 1.089)", want: "#FFFFFF"},
+		{name: "color xyz-d50 white", input: "color(xyz-d50 0.9643 1 0.8251)", want: "#FFFFFF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.Hex() != tt.want {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got.Hex(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+		wantToken  string
+	}{
+		{name: "Empty", input: "", wantOffset: 0},
+		{name: "Unknown name", input: "notacolor", wantOffset: 0, wantToken: "notacolor"},
+		{name: "Invalid hex", input: "#ZZ00FF", wantOffset: 0, wantToken: "#ZZ00FF"},
+		{name: "Short hex", input: "#12", wantOffset: 0, wantToken: "#12"},
+		{name: "Unknown function", input: "foo(1 2 3)", wantOffset: 0, wantToken: "foo("},
+		{name: "Invalid channel", input: "rgb(59 130 x)", wantOffset: 11, wantToken: "x"},
+		{name: "Missing channel", input: "lab(50 10)", wantOffset: 9, wantToken: ")"},
+		{name: "Missing parenthesis", input: "rgb(59 130 246", wantOffset: 14},
+		{name: "Mixed separators", input: "rgb(59, 130 246)", wantOffset: 12, wantToken: "246"},
+		{name: "Legacy mixed types", input: "rgb(59, 51%, 246)", wantOffset: 8, wantToken: "51%"},
+		{name: "Legacy hsl without percentages", input: "hsl(217, 91, 60%)", wantOffset: 9, wantToken: "91"},
+		{name: "Legacy none", input: "rgb(none, 130, 246)", wantOffset: 4, wantToken: "none"},
+		{name: "Commas in modern function", input: "oklch(0.6, 0.1, 250)", wantOffset: 9, wantToken: ","},
+		{name: "Unknown angle unit", input: "oklch(62% 0.19 259px)", wantOffset: 15, wantToken: "259px"},
+		{name: "Percentage hue", input: "hsl(50% 100% 50%)", wantOffset: 4, wantToken: "50%"},
+		{name: "Unit on channel", input: "rgb(59px 130 246)", wantOffset: 4, wantToken: "59px"},
+		{name: "Unknown color space", input: "color(foo 1 2 3)", wantOffset: 6, wantToken: "foo"},
+		{name: "Trailing input", input: "rgb(59 130 246) x", wantOffset: 16, wantToken: "x"},
+		{name: "Unexpected character", input: "rgb(59 130 246 ; 1)", wantOffset: 15, wantToken: ";"},
+		{name: "Alpha with unit", input: "rgb(59 130 246 / 50px)", wantOffset: 17, wantToken: "50px"},
+		{name: "Hex with 5 digits", input: "#3B82F", wantOffset: 0, wantToken: "#3B82F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			if !errors.Is(err, ErrorInvalidColor) {
+				t.Fatalf("Parse(%q) error = %v, want ErrorInvalidColor", tt.input, err)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error = %T, want *ParseError", tt.input, err)
+			}
+			if parseErr.Offset != tt.wantOffset || parseErr.Token != tt.wantToken {
+				t.Errorf("Parse(%q) error at %d %q, want %d %q",
+					tt.input, parseErr.Offset, parseErr.Token, tt.wantOffset, tt.wantToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package color
+
+import (
+	"math"
+)
+
+// matrix3 is a 3x3 matrix converting between linear RGB and XYZ spaces.
+type matrix3 [3][3]float64
+
+func (m matrix3) apply(x, y, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+var (
+	xyzD65ToLinearSRGB = matrix3{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+	xyzD50ToD65 = matrix3{
+		{0.9554734527042182, -0.023098536874261423, 0.0632593086610217},
+		{-0.028369706963208136, 1.0099954580058226, 0.021041398966943008},
+		{0.012314001688319899, -0.020507696433477912, 1.3303659366080753},
+	}
+	linearP3ToXYZ = matrix3{
+		{0.4865709486482162, 0.26566769316909306, 0.1982172852343625},
+		{0.2289745640697488, 0.6917385218365064, 0.079286914093745},
+		{0, 0.04511338185890264, 1.043944368900976},
+	}
+	linearRec2020ToXYZ = matrix3{
+		{0.6369580483012914, 0.14461690358620832, 0.1688809751641721},
+		{0.2627002120112671, 0.6779980715188708, 0.05930171646986196},
+		{0, 0.028072693049087428, 1.060985057710791},
+	}
+	linearA98ToXYZ = matrix3{
+		{0.5766690429101305, 0.1855582379065463, 0.1882286462349947},
+		{0.29734497525053605, 0.6273635662554661, 0.07529145849399788},
+		{0.02703136138641234, 0.07068885253582723, 0.9913375368376388},
+	}
+	linearProPhotoToXYZD50 = matrix3{
+		{0.7977604896723027, 0.13518583717574031, 0.0313493495815248},
+		{0.2880711282292934, 0.7118432178101014, 0.00008565396060525902},
+		{0, 0, 0.8251046025104601},
+	}
+)
+
+// d50White is the D50 reference white used by CSS lab() and lch().
+var d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// labToXYZD50 converts CIELAB relative to D50, as used by CSS, to XYZ.
+func labToXYZD50(l, a, b float64) (x, y, z float64) {
+	fy := (l + 16) / 116
+	fx := a/500 + fy
+	fz := fy - b/200
+
+	x = labInverseF(fx)
+	z = labInverseF(fz)
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	} else {
+		y = l / labKappa
+	}
+
+	return x * d50White[0], y * d50White[1], z * d50White[2]
+}
+
+func labInverseF(f float64) float64 {
+	if f3 := f * f * f; f3 > labEpsilon {
+		return f3
+	}
+	return (116*f - 16) / labKappa
+}
+
+// xyzD65ToSRGB converts XYZ relative to D65 to gamma-encoded sRGB channels.
+// The channels are not clipped to [0, 1].
+func xyzD65ToSRGB(x, y, z float64) (r, g, b float64) {
+	r, g, b = xyzD65ToLinearSRGB.apply(x, y, z)
+	return signedLinearToSRGB(r), signedLinearToSRGB(g), signedLinearToSRGB(b)
+}
+
+// signedLinearToSRGB applies the sRGB transfer function, extending it to
+// negative values by symmetry.
+func signedLinearToSRGB(c float64) float64 {
+	if c < 0 {
+		return -linearToSRGB(-c)
+	}
+	return linearToSRGB(c)
+}
+
+func signedSRGBToLinear(c float64) float64 {
+	if c < 0 {
+		return -srgbToLinear(-c)
+	}
+	return srgbToLinear(c)
+}
+
+func rec2020ToLinear(c float64) float64 {
+	const alpha, beta = 1.09929682680944, 0.018053968510807
+	sign := 1.0
+	if c < 0 {
+		sign, c = -1, -c
+	}
+	if c < beta*4.5 {
+		return sign * c / 4.5
+	}
+	return sign * math.Pow((c+alpha-1)/alpha, 1/0.45)
+}
+
+func a98ToLinear(c float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(c), 563.0/256), c)
+}
+
+func proPhotoToLinear(c float64) float64 {
+	if math.Abs(c) <= 16.0/512 {
+		return c / 16
+	}
+	return math.Copysign(math.Pow(math.Abs(c), 1.8), c)
+}
+
+// predefinedToSRGB converts channels of a CSS color() predefined color space
+// to gamma-encoded sRGB. It reports false for unknown spaces.
+func predefinedToSRGB(space string, c0, c1, c2 float64) (r, g, b float64, ok bool) {
+	switch space {
+	case "srgb":
+		return c0, c1, c2, true
+	case "srgb-linear":
+		return signedLinearToSRGB(c0), signedLinearToSRGB(c1), signedLinearToSRGB(c2), true
+	case "display-p3":
+		x, y, z := linearP3ToXYZ.apply(signedSRGBToLinear(c0), signedSRGBToLinear(c1), signedSRGBToLinear(c2))
+		r, g, b = xyzD65ToSRGB(x, y, z)
+	case "rec2020":
+		x, y, z := linearRec2020ToXYZ.apply(rec2020ToLinear(c0), rec2020ToLinear(c1), rec2020ToLinear(c2))
+		r, g, b = xyzD65ToSRGB(x, y, z)
+	case "a98-rgb":
+		x, y, z := linearA98ToXYZ.apply(a98ToLinear(c0), a98ToLinear(c1), a98ToLinear(c2))
+		r, g, b = xyzD65ToSRGB(x, y, z)
+	case "prophoto-rgb":
+		x, y, z := linearProPhotoToXYZD50.apply(proPhotoToLinear(c0), proPhotoToLinear(c1), proPhotoToLinear(c2))
+		r, g, b = xyzD65ToSRGB(xyzD50ToD65.apply(x, y, z))
+	case "xyz", "xyz-d65":
+		r, g, b = xyzD65ToSRGB(c0, c1, c2)
+	case "xyz-d50":
+		r, g, b = xyzD65ToSRGB(xyzD50ToD65.apply(c0, c1, c2))
+	default:
+		return 0, 0, 0, false
+	}
+	return r, g, b, true
+}
+
+// hslToSRGB converts HSL with hue in degrees and saturation and lightness in
+// [0, 1] to gamma-encoded sRGB.
+func hslToSRGB(h, s, l float64) (r, g, b float64) {
+	if s == 0 {
+		return l, l, l
+	}
+
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+
+	h /= 360
+	return calculateRGBComponent(p, q, h+1.0/3.0),
+		calculateRGBComponent(p, q, h),
+		calculateRGBComponent(p, q, h-1.0/3.0)
+}
+
+// hwbToSRGB converts HWB with hue in degrees and whiteness and blackness in
+// [0, 1] to gamma-encoded sRGB.
+func hwbToSRGB(h, w, bk float64) (r, g, b float64) {
+	if w+bk >= 1 {
+		gray := w / (w + bk)
+		return gray, gray, gray
+	}
+
+	r, g, b = hslToSRGB(h, 1, 0.5)
+	scale := 1 - w - bk
+	return r*scale + w, g*scale + w, b*scale + w
+}
+
+// srgbToColor clips gamma-encoded sRGB channels to the gamut and rounds them
+// to an opaque 8-bit color.
+func srgbToColor(r, g, b float64) Color {
+	channel := func(c float64) uint8 {
+		return uint8(math.Round(math.Max(0, math.Min(1, c)) * 255))
+	}
+	return Color{R: channel(r), G: channel(g), B: channel(b), A: 255}
+}
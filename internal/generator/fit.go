@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"errors"
+	"math"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+var (
+	ErrorNothingToFit = errors.New("palette to fit has no shades")
+)
+
+// minFitSaturation is the saturation (HSL) or chroma (OKLCH) below which a
+// shade's hue is unreliable, so its hue shift is not fitted.
+const minFitSaturation = 0.02
+
+// FitOptions estimates the options that reproduce a palette in the given
+// mode: the lightness of each shade, its saturation (HSL) or chroma (OKLCH
+// and neutral) multiplier and its hue shift. They are fitted to the
+// generation model of the mode, relative to the palette's most colorful
+// shade, which FitOptions also returns: generating from that shade's color
+// reproduces the palette, up to the rounding of the fitted values, and
+// generating from other colors gives palettes of the same shape.
+//
+// In ModeNeutral the reference shade has a multiplier of 1 and the options
+// carry the tint strength that gives it its chroma. Tint strengths are
+// capped at 1, so only the lightness and hue of palettes more colorful than
+// a neutral are reproduced.
+func FitOptions(swatches []Swatch, mode Mode) (Options, Swatch, error) {
+	if len(swatches) == 0 {
+		return Options{}, Swatch{}, ErrorNothingToFit
+	}
+
+	// measure returns a shade's lightness, saturation or chroma, and hue.
+	// colorful scores how colorful a shade looks, to pick the reference
+	// shade, and multiplier returns the saturation multiplier that
+	// generation needs to turn the reference's saturation into a shade's at
+	// lightness l in [0, 1].
+	var measure func(hex string) (l, s, h float64, err error)
+	var colorful func(l, s float64) float64
+	var multiplier func(l, s, ref float64) float64
+	minReference := minFitSaturation
+	switch mode {
+	case "", ModeHSL:
+		mode = ModeHSL
+		measure = func(hex string) (l, s, h float64, err error) {
+			h, s, l, err = color.HexToHSL(hex)
+			return l, s, h, err
+		}
+		colorful = func(l, s float64) float64 {
+			return s * (1 - math.Abs(2*l-1))
+		}
+		multiplier = func(_, s, ref float64) float64 {
+			return s / ref
+		}
+	case ModeOKLCH:
+		measure = color.HexToOKLCH
+		colorful = func(_, c float64) float64 {
+			return c
+		}
+		// Generation tapers the base chroma by lightness before applying
+		// the multiplier.
+		multiplier = func(l, c, ref float64) float64 {
+			return c / (ref * chromaTaper(l))
+		}
+	case ModeNeutral:
+		measure = color.HexToOKLCH
+		colorful = func(_, c float64) float64 {
+			return c
+		}
+		multiplier = func(_, c, ref float64) float64 {
+			return c / ref
+		}
+		// Generation tints down to minTintChroma, well below the chroma of
+		// neutral shades whose hue is reliable.
+		minReference = minTintChroma
+	default:
+		return Options{}, Swatch{}, ErrorInvalidMode
+	}
+
+	lightness := make([]float64, len(swatches))
+	saturation := make([]float64, len(swatches))
+	hues := make([]float64, len(swatches))
+	ref := 0
+	for i, swatch := range swatches {
+		c, err := color.ParseHex(swatch.Hex)
+		if err != nil {
+			return Options{}, Swatch{}, err
+		}
+		lightness[i], saturation[i], hues[i], err = measure(c.Opaque().Hex())
+		if err != nil {
+			return Options{}, Swatch{}, err
+		}
+		if colorful(lightness[i], saturation[i]) > colorful(lightness[ref], saturation[ref]) {
+			ref = i
+		}
+	}
+
+	shades := make([]Shade, len(swatches))
+	for i, swatch := range swatches {
+		l := uint8(math.Round(math.Max(0, math.Min(1, lightness[i])) * 100))
+		shade := NewShade(swatch.Name, l)
+
+		if saturation[ref] >= minReference {
+			shade = shade.WithSaturation(roundTo(multiplier(float64(l)/100, saturation[i], saturation[ref]), 100))
+			if saturation[i] >= minFitSaturation {
+				shift := math.Mod(hues[i]-hues[ref]+540, 360) - 180
+				shade = shade.WithHueShift(roundTo(shift, 10))
+			}
+		}
+		shades[i] = shade
+	}
+
+	opts := NewOptions(shades).WithMode(mode)
+	if mode == ModeNeutral {
+		opts = opts.WithTint(math.Min(1, roundTo(saturation[ref]/neutralChroma, 100)))
+	}
+	return opts, swatches[ref], nil
+}
+
+// roundTo rounds x to 1/scale.
+func roundTo(x, scale float64) float64 {
+	return math.Round(x*scale) / scale
+}
@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+func TestFitOptions(t *testing.T) {
+	generated := func(hex string, opts Options) []Swatch {
+		t.Helper()
+		palette, err := GeneratePalette(hex, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return palette.Swatches
+	}
+
+	tests := map[string]struct {
+		swatches  []Swatch
+		mode      Mode
+		maxDeltaE float64
+		wantErr   error
+	}{
+		"Default HSL palette": {
+			swatches:  generated("#3B82F6", DefaultTailwindOptions()),
+			mode:      ModeHSL,
+			maxDeltaE: 1,
+		},
+		"Hue-shifted HSL palette": {
+			swatches:  generated("#3B82F6", DefaultTailwindOptions().WithHueShift(20, HueShiftNatural)),
+			mode:      ModeHSL,
+			maxDeltaE: 1,
+		},
+		"Perceptual palette": {
+			swatches:  generated("#E11D48", DefaultPerceptualOptions()),
+			mode:      ModeOKLCH,
+			maxDeltaE: 1,
+		},
+		"Perceptual palette with very dark shades": {
+			swatches: generated("#3B82F6", NewOptions([]Shade{
+				NewShade("50", 97), NewShade("500", 55), NewShade("800", 25),
+				NewShade("900", 18).WithSaturation(1.5), NewShade("950", 12).WithHueShift(8),
+			}).WithMode(ModeOKLCH)),
+			mode:      ModeOKLCH,
+			maxDeltaE: 1,
+		},
+		"Perceptual palette refitted from a reference away from mid lightness": {
+			swatches: generated("#0EA5E9", NewOptions([]Shade{
+				NewShade("100", 90), NewShade("300", 80), NewShade("800", 30),
+			}).WithMode(ModeOKLCH)),
+			mode:      ModeOKLCH,
+			maxDeltaE: 1,
+		},
+		"Hand-made palette": {
+			swatches: []Swatch{
+				{Name: "50", Hex: "#EFF6FF"}, {Name: "100", Hex: "#DBEAFE"},
+				{Name: "200", Hex: "#BFDBFE"}, {Name: "300", Hex: "#93C5FD"},
+				{Name: "400", Hex: "#60A5FA"}, {Name: "500", Hex: "#3B82F6"},
+				{Name: "600", Hex: "#2563EB"}, {Name: "700", Hex: "#1D4ED8"},
+				{Name: "800", Hex: "#1E40AF"}, {Name: "900", Hex: "#1E3A8A"},
+				{Name: "950", Hex: "#172554"},
+			},
+			mode:      ModeHSL,
+			maxDeltaE: 1.5,
+		},
+		"Gray palette": {
+			swatches:  []Swatch{{Name: "light", Hex: "#EEEEEE"}, {Name: "dark", Hex: "#333333"}},
+			mode:      ModeOKLCH,
+			maxDeltaE: 1,
+		},
+		"Neutral palette": {
+			swatches:  generated("#3B82F6", DefaultNeutralOptions().WithTint(0.8)),
+			mode:      ModeNeutral,
+			maxDeltaE: 1,
+		},
+		"Hand-made neutral palette": {
+			swatches: []Swatch{
+				{Name: "50", Hex: "#F8FAFC"}, {Name: "100", Hex: "#F1F5F9"},
+				{Name: "200", Hex: "#E2E8F0"}, {Name: "300", Hex: "#CAD5E2"},
+				{Name: "400", Hex: "#90A1B9"}, {Name: "500", Hex: "#62748E"},
+				{Name: "600", Hex: "#45556C"}, {Name: "700", Hex: "#314158"},
+				{Name: "800", Hex: "#1D293D"}, {Name: "900", Hex: "#0F172B"},
+				{Name: "950", Hex: "#020618"},
+			},
+			mode:      ModeNeutral,
+			maxDeltaE: 1.5,
+		},
+		"Empty palette": {
+			mode:    ModeHSL,
+			wantErr: ErrorNothingToFit,
+		},
+		"Invalid mode": {
+			swatches: []Swatch{{Name: "500", Hex: "#3B82F6"}},
+			mode:     "lab",
+			wantErr:  ErrorInvalidMode,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, ref, err := FitOptions(tt.swatches, tt.mode)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			palette, err := GeneratePalette(ref.Hex, opts)
+			if err != nil {
+				t.Fatalf("GeneratePalette() error = %v", err)
+			}
+			for i, swatch := range palette.Swatches {
+				want := tt.swatches[i]
+				if swatch.Name != want.Name {
+					t.Fatalf("shade %d = %s, want %s", i, swatch.Name, want.Name)
+				}
+				d, err := color.DeltaE2000(swatch.Hex, want.Hex)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d > tt.maxDeltaE {
+					t.Errorf("shade %s = %s, want %s (ΔE %.2f)", swatch.Name, swatch.Hex, want.Hex, d)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"math"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+// ContrastMethod selects how the foreground of a shade is measured.
+type ContrastMethod string
+
+const (
+	// ContrastWCAG measures the WCAG 2.x contrast ratio, from 1 to 21.
+	ContrastWCAG ContrastMethod = "wcag"
+	// ContrastAPCA measures the absolute APCA Lc of the foreground as text
+	// on the shade, from 0 to about 108.
+	ContrastAPCA ContrastMethod = "apca"
+)
+
+const (
+	// DefaultForegroundTarget is the WCAG AA ratio for normal text.
+	DefaultForegroundTarget = color.ContrastAA
+	// DefaultAPCAForegroundTarget is the APCA Lc recommended for body text.
+	DefaultAPCAForegroundTarget = 60.0
+)
+
+const (
+	whiteHex = "#FFFFFF"
+	blackHex = "#000000"
+)
+
+// WithForeground returns a copy of the options whose foregrounds must reach
+// target, measured with method. By default foregrounds target the WCAG AA
+// ratio of 4.5.
+func (o Options) WithForeground(method ContrastMethod, target float64) Options {
+	o.foreground = method
+	o.foregroundTarget = target
+	return o
+}
+
+// Foreground returns the contrast method and target foregrounds are chosen
+// with.
+func (o Options) Foreground() (ContrastMethod, float64) {
+	if o.foreground == "" {
+		return ContrastWCAG, DefaultForegroundTarget
+	}
+	return o.foreground, o.foregroundTarget
+}
+
+// setForegrounds sets the foreground of every swatch. The most contrasting
+// other shade of the palette is used if it reaches the target, so text stays
+// in the palette's hue; otherwise white or black, whichever contrasts more,
+// even if neither reaches the target.
+func setForegrounds(palette *Palette, opts Options) error {
+	method, target := opts.Foreground()
+	if method != ContrastWCAG && method != ContrastAPCA {
+		return ErrorInvalidContrastMethod
+	}
+	if target < 0 {
+		return ErrorInvalidContrastTarget
+	}
+
+	shades := make([]color.Color, len(palette.Swatches))
+	for i, swatch := range palette.Swatches {
+		c, err := color.ParseHex(swatch.Hex)
+		if err != nil {
+			return err
+		}
+		shades[i] = c.Opaque()
+	}
+
+	white, _ := color.ParseHex(whiteHex)
+	black, _ := color.ParseHex(blackHex)
+
+	for i, background := range shades {
+		best, bestContrast := -1, 0.0
+		for j, text := range shades {
+			if j == i {
+				continue
+			}
+			if c := contrast(method, text, background); c >= target && c > bestContrast {
+				best, bestContrast = j, c
+			}
+		}
+
+		swatch := &palette.Swatches[i]
+		switch {
+		case best >= 0:
+			swatch.Foreground = shades[best].Hex()
+			swatch.ForegroundShade = palette.Swatches[best].Name
+		case contrast(method, white, background) >= contrast(method, black, background):
+			swatch.Foreground = whiteHex
+		default:
+			swatch.Foreground = blackHex
+		}
+	}
+
+	return nil
+}
+
+func contrast(method ContrastMethod, text, background color.Color) float64 {
+	if method == ContrastAPCA {
+		return math.Abs(text.APCAContrast(background))
+	}
+	return text.ContrastRatio(background)
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+func TestForeground(t *testing.T) {
+	tests := map[string]struct {
+		hex     string
+		opts    Options
+		want    map[string]string
+		wantErr error
+	}{
+		"Palette shades by default": {
+			hex:  "#3B82F6",
+			opts: DefaultTailwindOptions(),
+			want: map[string]string{"50": "950", "400": "950", "500": "50", "950": "50"},
+		},
+		"Black or white when no shade reaches the target": {
+			hex:  "#3B82F6",
+			opts: DefaultTailwindOptions().WithForeground(ContrastWCAG, 7),
+			want: map[string]string{"400": "#000000", "500": "#FFFFFF", "600": "50"},
+		},
+		"APCA target": {
+			hex:  "#3B82F6",
+			opts: DefaultTailwindOptions().WithForeground(ContrastAPCA, 60),
+			want: map[string]string{"300": "950", "400": "#FFFFFF", "500": "50"},
+		},
+		"Best effort when nothing reaches the target": {
+			hex:  "#808080",
+			opts: NewOptions([]Shade{NewShade("500", 50)}).WithForeground(ContrastWCAG, 21),
+			want: map[string]string{"500": "#000000"},
+		},
+		"Invalid method": {
+			hex:     "#3B82F6",
+			opts:    DefaultTailwindOptions().WithForeground("contrast", 4.5),
+			wantErr: ErrorInvalidContrastMethod,
+		},
+		"Negative target": {
+			hex:     "#3B82F6",
+			opts:    DefaultTailwindOptions().WithForeground(ContrastAPCA, -1),
+			wantErr: ErrorInvalidContrastTarget,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePalette(tt.hex, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+
+			for _, swatch := range got.Swatches {
+				want, ok := tt.want[swatch.Name]
+				if !ok {
+					continue
+				}
+				if want[0] == '#' {
+					if swatch.Foreground != want || swatch.ForegroundShade != "" {
+						t.Errorf("shade %s: got %s (%q), want %s", swatch.Name, swatch.Foreground, swatch.ForegroundShade, want)
+					}
+					continue
+				}
+				fg, _ := got.Get(want)
+				if swatch.ForegroundShade != want || swatch.Foreground != fg {
+					t.Errorf("shade %s: got %s (%q), want shade %s (%s)", swatch.Name, swatch.Foreground, swatch.ForegroundShade, want, fg)
+				}
+			}
+		})
+	}
+}
+
+func TestForegroundMeetsTarget(t *testing.T) {
+	got, err := GeneratePalette("#3B82F680", DefaultPerceptualOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, swatch := range got.Swatches {
+		if len(swatch.Foreground) != 7 {
+			t.Errorf("shade %s: foreground %s is not opaque", swatch.Name, swatch.Foreground)
+		}
+		ratio, err := color.ContrastRatio(swatch.Foreground, swatch.Hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ratio < DefaultForegroundTarget {
+			t.Errorf("shade %s: foreground %s has contrast %.2f, want at least %v", swatch.Name, swatch.Foreground, ratio, DefaultForegroundTarget)
+		}
+	}
+}
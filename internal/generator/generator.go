@@ -2,13 +2,34 @@ package generator
 
 import (
 	"errors"
+	"math"
 
 	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
 )
 
+// Mode selects the color space a palette is generated in.
+type Mode string
+
+const (
+	// ModeHSL keeps the base hue and saturation and sets HSL lightness per shade.
+	ModeHSL Mode = "hsl"
+	// ModeOKLCH keeps the base hue, sets perceptual (OKLab) lightness per shade
+	// and tapers chroma toward the light and dark ends of the scale.
+	ModeOKLCH Mode = "oklch"
+	// ModeNeutral keeps only the base hue and generates a low-chroma gray
+	// scale tinted toward it. See Options.WithTint.
+	ModeNeutral Mode = "neutral"
+)
+
+// Shade is a named step of a palette scale together with its target
+// lightness in percent, a saturation multiplier and an optional fixed hue
+// rotation in degrees.
 type Shade struct {
-	name      string
-	lightness uint8
+	name          string
+	lightness     uint8
+	saturation    float64
+	hasSaturation bool
+	hueShift      float64
 }
 
 func NewShade(name string, lightness uint8) Shade {
@@ -18,57 +39,428 @@ func NewShade(name string, lightness uint8) Shade {
 	}
 }
 
+func (s Shade) Name() string {
+	return s.name
+}
+
+func (s Shade) Lightness() uint8 {
+	return s.lightness
+}
+
+// WithSaturation returns a copy of the shade whose saturation (HSL) or chroma
+// (OKLCH) is the base color's multiplied by m.
+func (s Shade) WithSaturation(m float64) Shade {
+	s.saturation = m
+	s.hasSaturation = true
+	return s
+}
+
+// Saturation returns the shade's saturation multiplier, 1 unless set.
+func (s Shade) Saturation() float64 {
+	if !s.hasSaturation {
+		return 1
+	}
+	return s.saturation
+}
+
+// WithHueShift returns a copy of the shade whose hue is rotated by degrees,
+// on top of any hue-shift curve set on the Options. Generating fails with
+// ErrorInvalidHueDegrees if degrees is not finite.
+func (s Shade) WithHueShift(degrees float64) Shade {
+	s.hueShift = degrees
+	return s
+}
+
+func (s Shade) HueShift() float64 {
+	return s.hueShift
+}
+
+// Options describes the shade scale and the mode a palette is generated with.
 type Options struct {
-	shades []Shade
+	shades    []Shade
+	mode      Mode
+	anchor    string
+	hueShift  float64
+	direction HueShiftDirection
+
+	foreground       ContrastMethod
+	foregroundTarget float64
+
+	tint    float64
+	hasTint bool
 }
 
+// AnchorAuto anchors the base color at the shade whose lightness is closest
+// to its own.
+const AnchorAuto = "auto"
+
 func NewOptions(shades []Shade) Options {
 	return Options{
 		shades: shades,
 	}
 }
 
+// Shades returns a copy of the shade scale, in order.
+func (o Options) Shades() []Shade {
+	return append([]Shade(nil), o.shades...)
+}
+
+func (o Options) Mode() Mode {
+	if o.mode == "" {
+		return ModeHSL
+	}
+	return o.mode
+}
+
+// WithShades returns a copy of the options with a different shade scale.
+func (o Options) WithShades(shades []Shade) Options {
+	o.shades = append([]Shade(nil), shades...)
+	return o
+}
+
+// WithMode returns a copy of the options that generates in the given mode.
+func (o Options) WithMode(mode Mode) Options {
+	o.mode = mode
+	return o
+}
+
+// WithAnchor returns a copy of the options that reproduces the base color
+// exactly at the named shade, or at the best-fitting shade for AnchorAuto.
+// The lightness of the other shades is redistributed around it. An empty
+// name disables anchoring. ModeNeutral does not support anchors.
+func (o Options) WithAnchor(name string) Options {
+	o.anchor = name
+	return o
+}
+
+func (o Options) Anchor() string {
+	return o.anchor
+}
+
+// WithSaturation returns a copy of the options with the saturation
+// multiplier of the named shade overridden. It has no effect if the scale has
+// no shade with that name.
+func (o Options) WithSaturation(name string, m float64) Options {
+	shades := o.Shades()
+	for i := range shades {
+		if shades[i].name == name {
+			shades[i] = shades[i].WithSaturation(m)
+		}
+	}
+	o.shades = shades
+	return o
+}
+
+// WithHueShift returns a copy of the options that rotates the hue of each
+// shade by up to degrees, in proportion to how far its lightness is from the
+// base color. See HueShiftDirection for which way shades are rotated; a
+// negative number of degrees rotates them the other way. Generating fails
+// with ErrorInvalidHueDegrees if degrees is not finite.
+func (o Options) WithHueShift(degrees float64, direction HueShiftDirection) Options {
+	o.hueShift = degrees
+	o.direction = direction
+	return o
+}
+
+func (o Options) HueShift() (float64, HueShiftDirection) {
+	return o.hueShift, o.direction
+}
+
+// DefaultTailwindOptions returns the Tailwind scale in HSL lightness. The
+// lightest and darkest shades are desaturated so they do not turn neon.
 func DefaultTailwindOptions() Options {
 	return Options{
 		shades: []Shade{
-			NewShade("50", 98),
-			NewShade("100", 95),
-			NewShade("200", 90),
-			NewShade("300", 82),
+			NewShade("50", 98).WithSaturation(0.95),
+			NewShade("100", 95).WithSaturation(0.95),
+			NewShade("200", 90).WithSaturation(0.97),
+			NewShade("300", 82).WithSaturation(0.98),
 			NewShade("400", 64),
 			NewShade("500", 46),
-			NewShade("600", 33),
-			NewShade("700", 24),
-			NewShade("800", 14),
-			NewShade("900", 7),
-			NewShade("950", 4),
+			NewShade("600", 33).WithSaturation(0.95),
+			NewShade("700", 24).WithSaturation(0.9),
+			NewShade("800", 14).WithSaturation(0.85),
+			NewShade("900", 7).WithSaturation(0.8),
+			NewShade("950", 4).WithSaturation(0.75),
+		},
+	}
+}
+
+// DefaultPerceptualOptions returns the Tailwind scale expressed in OKLab
+// lightness, modelled on the Tailwind CSS v4 default palette. Chroma is
+// already tapered toward both ends in ModeOKLCH, so every shade keeps a
+// saturation multiplier of 1.
+func DefaultPerceptualOptions() Options {
+	return Options{
+		shades: []Shade{
+			NewShade("50", 97),
+			NewShade("100", 94),
+			NewShade("200", 89),
+			NewShade("300", 81),
+			NewShade("400", 71),
+			NewShade("500", 63),
+			NewShade("600", 55),
+			NewShade("700", 49),
+			NewShade("800", 43),
+			NewShade("900", 38),
+			NewShade("950", 27),
 		},
+		mode: ModeOKLCH,
 	}
 }
 
 var (
-	ErrorInvalidLightness = errors.New("lightness must be between 0 and 100")
+	ErrorInvalidLightness  = errors.New("lightness must be between 0 and 100")
+	ErrorInvalidMode       = errors.New("invalid mode: must be one of 'hsl', 'oklch' or 'neutral'")
+	ErrorUnknownAnchor     = errors.New("anchor must be 'auto' or the name of a shade")
+	ErrorInvalidSaturation = errors.New("saturation multiplier must not be negative")
+	ErrorInvalidHueShift   = errors.New("invalid hue shift direction: must be one of 'natural' or 'inverse'")
+	ErrorInvalidHueDegrees = errors.New("hue shift must be a finite number of degrees")
+
+	ErrorInvalidContrastMethod = errors.New("invalid contrast method: must be one of 'wcag' or 'apca'")
+	ErrorInvalidContrastTarget = errors.New("invalid contrast target: must be a non-negative number")
+	ErrorInvalidTint           = errors.New("tint strength must be between 0 and 1")
+	ErrorNeutralAnchor         = errors.New("anchor cannot be used in neutral mode: neutral shades do not reproduce the base color")
 )
 
+// Swatch is a single generated shade. Hex is #RRGGBB, or #RRGGBBAA for
+// translucent palettes. Foreground is the opaque #RRGGBB text color to use
+// on the shade; ForegroundShade names the palette shade it was taken from,
+// and is empty for white or black.
+type Swatch struct {
+	Name            string
+	Hex             string
+	Foreground      string
+	ForegroundShade string
+}
+
+// Palette is the ordered list of swatches generated from a base color.
+// Anchor names the shade that equals the base color, if any.
+type Palette struct {
+	Base     string
+	Anchor   string
+	Swatches []Swatch
+}
+
+// Get returns the hex value of the named shade.
+func (p Palette) Get(name string) (string, bool) {
+	for _, swatch := range p.Swatches {
+		if swatch.Name == name {
+			return swatch.Hex, true
+		}
+	}
+	return "", false
+}
+
+// Swatch returns the named swatch.
+func (p Palette) Swatch(name string) (Swatch, bool) {
+	for _, swatch := range p.Swatches {
+		if swatch.Name == name {
+			return swatch, true
+		}
+	}
+	return Swatch{}, false
+}
+
+// Map returns the palette keyed by shade name.
+func (p Palette) Map() map[string]string {
+	m := make(map[string]string, len(p.Swatches))
+	for _, swatch := range p.Swatches {
+		m[swatch.Name] = swatch.Hex
+	}
+	return m
+}
+
 func GeneratePaletteFromHex(hex string, opts Options) (palette map[string]string, err error) {
-	h, s, _, err := color.HexToHSL(hex)
+	p, err := GeneratePalette(hex, opts)
 	if err != nil {
 		return nil, err
 	}
-	palette = make(map[string]string)
+	return p.Map(), nil
+}
+
+// GeneratePalette generates the shades of opts from a base color, keeping
+// the order of the shade scale. The base color may be any CSS color, such as
+// "#3B82F6", "rgb(59 130 246)" or "cornflowerblue"; the palette's Base is
+// its #RRGGBB hex form. The alpha of a translucent base color is kept on
+// every shade, which are then #RRGGBBAA. Every swatch gets a foreground;
+// see Options.WithForeground.
+func GeneratePalette(value string, opts Options) (Palette, error) {
+	c, err := color.Parse(value)
+	if err != nil {
+		return Palette{}, err
+	}
+	hex := c.Opaque().Hex()
+
+	var base baseColor
+
+	switch opts.mode {
+	case "", ModeHSL:
+		base, err = hslBase(hex)
+	case ModeOKLCH:
+		base, err = oklchBase(hex)
+	case ModeNeutral:
+		base, err = neutralBase(hex, opts)
+	default:
+		err = ErrorInvalidMode
+	}
+	if err != nil {
+		return Palette{}, err
+	}
 
-	for _, shade := range opts.shades {
-		l := float64(shade.lightness) / 100.0
-		if l < 0 || l > 1 {
-			return nil, ErrorInvalidLightness
+	lightness, anchor, err := shadeLightness(opts, base.lightness)
+	if err != nil {
+		return Palette{}, err
+	}
+
+	hues, err := shadeHues(opts, base, lightness)
+	if err != nil {
+		return Palette{}, err
+	}
+
+	palette := Palette{Base: c.Hex(), Swatches: make([]Swatch, 0, len(opts.shades))}
+	for i, shade := range opts.shades {
+		if i == anchor {
+			palette.Anchor = shade.name
+			palette.Swatches = append(palette.Swatches, Swatch{Name: shade.name, Hex: c.Hex()})
+			continue
+		}
+
+		if shade.Saturation() < 0 {
+			return Palette{}, ErrorInvalidSaturation
 		}
 
-		palette[shade.name], err = color.HSLToHex(h, s, l)
+		value, err := base.shade(lightness[i]/100, hues[i], shade.Saturation())
 		if err != nil {
-			return nil, err
+			return Palette{}, err
+		}
+		if c.A != 255 {
+			shadeColor, err := color.ParseHex(value)
+			if err != nil {
+				return Palette{}, err
+			}
+			shadeColor.A = c.A
+			value = shadeColor.Hex()
 		}
+		palette.Swatches = append(palette.Swatches, Swatch{Name: shade.name, Hex: value})
+	}
 
+	if err := setForegrounds(&palette, opts); err != nil {
+		return Palette{}, err
 	}
 
 	return palette, nil
 }
+
+// baseColor holds what a mode keeps from the base color: its lightness in
+// percent and hue in degrees on the mode's own scale, the hues the mode
+// considers warm and cool, and a function that renders a shade at a given
+// lightness in [0, 1], hue and saturation multiplier.
+type baseColor struct {
+	lightness float64
+	hue       float64
+	warmHue   float64
+	coolHue   float64
+	shade     func(l, h, m float64) (string, error)
+}
+
+func hslBase(hex string) (baseColor, error) {
+	h, s, l, err := color.HexToHSL(hex)
+	if err != nil {
+		return baseColor{}, err
+	}
+
+	return baseColor{
+		lightness: l * 100,
+		hue:       h,
+		warmHue:   50,
+		coolHue:   230,
+		shade: func(l, h, m float64) (string, error) {
+			return color.HSLToHex(h, math.Min(1, s*m), l)
+		},
+	}, nil
+}
+
+func oklchBase(hex string) (baseColor, error) {
+	l, c, h, err := color.HexToOKLCH(hex)
+	if err != nil {
+		return baseColor{}, err
+	}
+
+	return baseColor{
+		lightness: l * 100,
+		hue:       h,
+		warmHue:   70,
+		coolHue:   265,
+		shade: func(l, h, m float64) (string, error) {
+			sc := math.Min(c*chromaTaper(l)*m, color.MaxChroma(l, h))
+			return color.OKLCHToHex(l, sc, h)
+		},
+	}, nil
+}
+
+// shadeLightness returns the lightness of every shade in percent, and the
+// index of the anchored shade or -1. Shades around an anchor are rescaled so
+// that the anchor lands on baseLightness while white and black stay fixed.
+func shadeLightness(opts Options, baseLightness float64) ([]float64, int, error) {
+	lightness := make([]float64, len(opts.shades))
+	for i, shade := range opts.shades {
+		if shade.lightness > 100 {
+			return nil, -1, ErrorInvalidLightness
+		}
+		lightness[i] = float64(shade.lightness)
+	}
+
+	anchor, err := anchorIndex(opts, baseLightness)
+	if err != nil || anchor < 0 {
+		return lightness, anchor, err
+	}
+
+	from := lightness[anchor]
+	for i, l := range lightness {
+		switch {
+		case l > from:
+			lightness[i] = 100 - (100-l)*(100-baseLightness)/(100-from)
+		case l < from:
+			lightness[i] = l * baseLightness / from
+		}
+	}
+	lightness[anchor] = baseLightness
+
+	return lightness, anchor, nil
+}
+
+func anchorIndex(opts Options, baseLightness float64) (int, error) {
+	switch opts.anchor {
+	case "":
+		return -1, nil
+	case AnchorAuto:
+		best := -1
+		for i, shade := range opts.shades {
+			if best < 0 || math.Abs(float64(shade.lightness)-baseLightness) < math.Abs(float64(opts.shades[best].lightness)-baseLightness) {
+				best = i
+			}
+		}
+		return best, nil
+	default:
+		for i, shade := range opts.shades {
+			if shade.name == opts.anchor {
+				return i, nil
+			}
+		}
+		return -1, ErrorUnknownAnchor
+	}
+}
+
+// minChromaTaper keeps some chroma in very light and very dark shades, so
+// they do not turn gray.
+const minChromaTaper = 0.1
+
+// chromaTaper scales the base chroma down for shades far from mid lightness,
+// where saturated colors either leave the sRGB gamut or look muddy. It never
+// drops below minChromaTaper, so the darkest and lightest shades keep a
+// trace of the hue.
+func chromaTaper(l float64) float64 {
+	d := (l - 0.6) / 0.4
+	return math.Max(minChromaTaper, 1-d*d)
+}
@@ -1,7 +1,10 @@
 package generator
 
 import (
+	"math"
 	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
 )
 
 func TestGeneratePaletteFromHex(t *testing.T) {
@@ -45,19 +48,56 @@ func TestGeneratePaletteFromHex(t *testing.T) {
 			hex:  "#0066FF",
 			opts: DefaultTailwindOptions(),
 			want: map[string]string{
-				"50":  "#F4F8FF",
-				"100": "#E5EFFF",
+				"50":  "#F5F8FE",
+				"100": "#E6EFFE",
 				"200": "#CCE0FE",
-				"300": "#A3C7FE",
+				"300": "#A4C8FE",
 				"400": "#4790FF",
 				"500": "#005DEA",
-				"600": "#0043A8",
-				"700": "#00307A",
-				"800": "#001C47",
-				"900": "#000E23",
-				"950": "#000814",
+				"600": "#0444A4",
+				"700": "#063274",
+				"800": "#051D42",
+				"900": "#030E20",
+				"950": "#020811",
+			},
+		},
+		"Perceptual scale": {
+			hex:  "#3B82F6",
+			opts: DefaultPerceptualOptions(),
+			want: map[string]string{
+				"50":  "#F0F6FF",
+				"100": "#E0ECFF",
+				"200": "#C6DCFF",
+				"300": "#9DC2FF",
+				"400": "#67A0FF",
+				"500": "#3E84F8",
+				"600": "#256BDB",
+				"700": "#195AC1",
+				"800": "#124AA2",
+				"900": "#103E86",
+				"950": "#142643",
+			},
+		},
+		"Perceptual gray stays gray": {
+			hex: "#808080",
+			opts: NewOptions([]Shade{
+				NewShade("100", 94),
+				NewShade("500", 63),
+				NewShade("900", 38),
+			}).WithMode(ModeOKLCH),
+			want: map[string]string{
+				"100": "#EBEBEB",
+				"500": "#898989",
+				"900": "#424242",
 			},
 		},
+		"Invalid mode": {
+			hex: "#FF0000",
+			opts: NewOptions([]Shade{
+				NewShade("100", 90),
+			}).WithMode("lab"),
+			wantErr: true,
+		},
 		"Gray scale": {
 			hex: "#808080",
 			opts: NewOptions([]Shade{
@@ -90,6 +130,28 @@ func TestGeneratePaletteFromHex(t *testing.T) {
 			}),
 			wantErr: true,
 		},
+		"CSS color input": {
+			hex: "rgb(0 0 255)",
+			opts: NewOptions([]Shade{
+				NewShade("light", 80),
+				NewShade("dark", 20),
+			}),
+			want: map[string]string{
+				"light": "#9999FF",
+				"dark":  "#000066",
+			},
+		},
+		"Translucent base color": {
+			hex: "#0000FF80",
+			opts: NewOptions([]Shade{
+				NewShade("light", 80),
+				NewShade("dark", 20),
+			}),
+			want: map[string]string{
+				"light": "#9999FF80",
+				"dark":  "#00006680",
+			},
+		},
 		"Invalid lightness value": {
 			hex: "#FF0000",
 			opts: NewOptions([]Shade{
@@ -186,3 +248,276 @@ func TestDefaultTailwindOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultPerceptualOptions(t *testing.T) {
+	opts := DefaultPerceptualOptions()
+
+	if opts.mode != ModeOKLCH {
+		t.Errorf("got mode %q, want %q", opts.mode, ModeOKLCH)
+	}
+
+	if len(opts.shades) != 11 {
+		t.Fatalf("got %d shades, want 11", len(opts.shades))
+	}
+
+	for i := 1; i < len(opts.shades); i++ {
+		if opts.shades[i].lightness >= opts.shades[i-1].lightness {
+			t.Errorf("shade %s: lightness %d is not darker than shade %s",
+				opts.shades[i].name, opts.shades[i].lightness, opts.shades[i-1].name)
+		}
+	}
+}
+
+func TestPerceptualLightnessIsConsistentAcrossHues(t *testing.T) {
+	hexes := []string{"#3B82F6", "#EAB308", "#EF4444", "#22C55E"}
+	opts := DefaultPerceptualOptions()
+
+	for _, hex := range hexes {
+		t.Run(hex, func(t *testing.T) {
+			palette, err := GeneratePaletteFromHex(hex, opts)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+
+			for _, shade := range opts.shades {
+				l, _, _, err := color.HexToOKLCH(palette[shade.name])
+				if err != nil {
+					t.Fatalf("shade %s: error = %v", shade.name, err)
+				}
+				want := float64(shade.lightness) / 100
+				if math.Abs(l-want) > 0.01 {
+					t.Errorf("shade %s: got OKLab lightness %.3f, want %.2f", shade.name, l, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePaletteKeepsShadeOrder(t *testing.T) {
+	opts := NewOptions([]Shade{
+		NewShade("light", 80),
+		NewShade("dark", 20),
+		NewShade("medium", 50),
+	})
+
+	got, err := GeneratePalette("#0000FF", opts)
+	if err != nil {
+		t.Fatalf("error = %v", err)
+	}
+
+	if got.Base != "#0000FF" {
+		t.Errorf("got base %q, want %q", got.Base, "#0000FF")
+	}
+
+	wantOrder := []string{"light", "dark", "medium"}
+	if len(got.Swatches) != len(wantOrder) {
+		t.Fatalf("got %d swatches, want %d", len(got.Swatches), len(wantOrder))
+	}
+	for i, name := range wantOrder {
+		if got.Swatches[i].Name != name {
+			t.Errorf("swatch %d: got %q, want %q", i, got.Swatches[i].Name, name)
+		}
+	}
+
+	if hex, ok := got.Get("dark"); !ok || hex != "#000066" {
+		t.Errorf("Get(dark) = %q, %v, want %q, true", hex, ok, "#000066")
+	}
+	if _, ok := got.Get("missing"); ok {
+		t.Errorf("Get(missing) reported a shade")
+	}
+}
+
+func TestGeneratePaletteAnchor(t *testing.T) {
+	tests := map[string]struct {
+		hex        string
+		opts       Options
+		wantAnchor string
+		wantErr    error
+	}{
+		"Auto anchor picks closest HSL lightness": {
+			hex:        "#3B82F6",
+			opts:       DefaultTailwindOptions().WithAnchor(AnchorAuto),
+			wantAnchor: "400",
+		},
+		"Explicit anchor": {
+			hex:        "#3B82F6",
+			opts:       DefaultTailwindOptions().WithAnchor("500"),
+			wantAnchor: "500",
+		},
+		"Auto anchor picks closest perceptual lightness": {
+			hex:        "#3B82F6",
+			opts:       DefaultPerceptualOptions().WithAnchor(AnchorAuto),
+			wantAnchor: "500",
+		},
+		"Explicit anchor in perceptual mode": {
+			hex:        "#EAB308",
+			opts:       DefaultPerceptualOptions().WithAnchor("500"),
+			wantAnchor: "500",
+		},
+		"Unknown anchor": {
+			hex:     "#3B82F6",
+			opts:    DefaultTailwindOptions().WithAnchor("550"),
+			wantErr: ErrorUnknownAnchor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePalette(tt.hex, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if got.Anchor != tt.wantAnchor {
+				t.Errorf("got anchor %q, want %q", got.Anchor, tt.wantAnchor)
+			}
+			if hex, _ := got.Get(tt.wantAnchor); hex != tt.hex {
+				t.Errorf("anchor shade %s: got %q, want %q", tt.wantAnchor, hex, tt.hex)
+			}
+
+			prev := math.Inf(1)
+			for _, swatch := range got.Swatches {
+				l, _, _, err := color.HexToOKLCH(swatch.Hex)
+				if err != nil {
+					t.Fatalf("shade %s: error = %v", swatch.Name, err)
+				}
+				if l >= prev {
+					t.Errorf("shade %s: lightness %.3f is not darker than the previous shade", swatch.Name, l)
+				}
+				prev = l
+			}
+		})
+	}
+}
+
+func TestShadeLightnessRedistribution(t *testing.T) {
+	opts := NewOptions([]Shade{
+		NewShade("100", 90),
+		NewShade("500", 50),
+		NewShade("900", 10),
+	}).WithAnchor("500")
+
+	got, anchor, err := shadeLightness(opts, 60)
+	if err != nil {
+		t.Fatalf("error = %v", err)
+	}
+
+	if anchor != 1 {
+		t.Errorf("got anchor index %d, want 1", anchor)
+	}
+
+	want := []float64{92, 60, 12}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("shade %d: got lightness %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestShadeSaturation(t *testing.T) {
+	tests := map[string]struct {
+		hex     string
+		opts    Options
+		want    map[string]string
+		wantErr error
+	}{
+		"Unset saturation keeps the base saturation": {
+			hex:  "#FF0000",
+			opts: NewOptions([]Shade{NewShade("500", 50)}),
+			want: map[string]string{"500": "#FF0000"},
+		},
+		"Halved saturation": {
+			hex:  "#FF0000",
+			opts: NewOptions([]Shade{NewShade("500", 50).WithSaturation(0.5)}),
+			want: map[string]string{"500": "#BF3F3F"},
+		},
+		"Zero saturation is gray": {
+			hex:  "#FF0000",
+			opts: NewOptions([]Shade{NewShade("500", 50).WithSaturation(0)}),
+			want: map[string]string{"500": "#7F7F7F"},
+		},
+		"Saturation is capped": {
+			hex:  "#FF0000",
+			opts: NewOptions([]Shade{NewShade("500", 50).WithSaturation(2)}),
+			want: map[string]string{"500": "#FF0000"},
+		},
+		"Override on options": {
+			hex:  "#FF0000",
+			opts: NewOptions([]Shade{NewShade("100", 90), NewShade("500", 50)}).WithSaturation("500", 0),
+			want: map[string]string{"100": "#FECCCC", "500": "#7F7F7F"},
+		},
+		"Chroma multiplier in perceptual mode": {
+			hex:  "#808080",
+			opts: NewOptions([]Shade{NewShade("500", 63).WithSaturation(3)}).WithMode(ModeOKLCH),
+			want: map[string]string{"500": "#898989"},
+		},
+		"Negative saturation": {
+			hex:     "#FF0000",
+			opts:    NewOptions([]Shade{NewShade("500", 50).WithSaturation(-1)}),
+			wantErr: ErrorInvalidSaturation,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePaletteFromHex(tt.hex, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+
+			for name, hexValue := range tt.want {
+				if got[name] != hexValue {
+					t.Errorf("shade %s: got %q, want %q", name, got[name], hexValue)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultSaturationCurve(t *testing.T) {
+	opts := DefaultTailwindOptions()
+
+	for _, shade := range opts.shades {
+		if m := shade.Saturation(); m <= 0 || m > 1 {
+			t.Errorf("shade %s: got saturation %v, want (0, 1]", shade.name, m)
+		}
+	}
+
+	first, last := opts.shades[0], opts.shades[len(opts.shades)-1]
+	if first.Saturation() >= 1 || last.Saturation() >= 1 {
+		t.Errorf("extremes are not desaturated: 50 = %v, 950 = %v", first.Saturation(), last.Saturation())
+	}
+	if last.Saturation() >= first.Saturation() {
+		t.Errorf("950 (%v) should be desaturated more than 50 (%v)", last.Saturation(), first.Saturation())
+	}
+}
+
+func TestPerceptualDarkShadesKeepHue(t *testing.T) {
+	opts := NewOptions([]Shade{
+		NewShade("950", 18),
+		NewShade("975", 15).WithSaturation(3),
+		NewShade("990", 5),
+	}).WithMode(ModeOKLCH)
+
+	got, err := GeneratePalette("#3B82F6", opts)
+	if err != nil {
+		t.Fatalf("error = %v", err)
+	}
+	_, _, baseHue, _ := color.HexToOKLCH("#3B82F6")
+
+	for _, swatch := range got.Swatches {
+		_, c, h, err := color.HexToOKLCH(swatch.Hex)
+		if err != nil {
+			t.Fatalf("shade %s: error = %v", swatch.Name, err)
+		}
+		if c < 0.01 {
+			t.Errorf("shade %s: got %s with chroma %.3f, want a tinted shade", swatch.Name, swatch.Hex, c)
+		}
+		if d := math.Abs(math.Mod(h-baseHue+540, 360) - 180); d > 10 {
+			t.Errorf("shade %s: got hue %.1f, want close to %.1f", swatch.Name, h, baseHue)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"math"
+)
+
+// HueShiftDirection selects which way Options.WithHueShift rotates hues.
+type HueShiftDirection string
+
+const (
+	// HueShiftNatural rotates light shades toward warm hues and dark shades
+	// toward cool hues, as most hand-made palettes do.
+	HueShiftNatural HueShiftDirection = "natural"
+	// HueShiftInverse rotates light shades toward cool hues and dark shades
+	// toward warm hues.
+	HueShiftInverse HueShiftDirection = "inverse"
+)
+
+// shadeHues returns the hue of every shade. The curve rotates shades toward
+// the warm or cool hue by up to opts.hueShift degrees, scaled by how far the
+// shade's lightness is from the base color, so the base hue itself is kept.
+// A negative opts.hueShift swaps the warm and cool targets.
+func shadeHues(opts Options, base baseColor, lightness []float64) ([]float64, error) {
+	var lightTarget, darkTarget float64
+	switch opts.direction {
+	case "", HueShiftNatural:
+		lightTarget, darkTarget = base.warmHue, base.coolHue
+	case HueShiftInverse:
+		lightTarget, darkTarget = base.coolHue, base.warmHue
+	default:
+		return nil, ErrorInvalidHueShift
+	}
+	if !finite(opts.hueShift) {
+		return nil, ErrorInvalidHueDegrees
+	}
+	if opts.hueShift < 0 {
+		lightTarget, darkTarget = darkTarget, lightTarget
+	}
+
+	hues := make([]float64, len(lightness))
+	for i, l := range lightness {
+		if !finite(opts.shades[i].hueShift) {
+			return nil, ErrorInvalidHueDegrees
+		}
+
+		h := base.hue
+		switch {
+		case l > base.lightness:
+			t := (l - base.lightness) / (100 - base.lightness)
+			h = rotateToward(h, lightTarget, opts.hueShift*t)
+		case l < base.lightness:
+			t := (base.lightness - l) / base.lightness
+			h = rotateToward(h, darkTarget, opts.hueShift*t)
+		}
+		hues[i] = normalizeHue(h + opts.shades[i].hueShift)
+	}
+
+	return hues, nil
+}
+
+// rotateToward rotates hue h along the shorter arc toward target by at most
+// degrees, without overshooting it. The sign of degrees is ignored.
+func rotateToward(h, target, degrees float64) float64 {
+	d := math.Mod(target-h+540, 360) - 180
+	step := math.Min(math.Abs(d), math.Abs(degrees))
+	if d < 0 {
+		step = -step
+	}
+	return normalizeHue(h + step)
+}
+
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	if h >= 360 {
+		return 0
+	}
+	return h
+}
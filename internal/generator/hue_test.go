@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+func TestRotateToward(t *testing.T) {
+	tests := map[string]struct {
+		h       float64
+		target  float64
+		degrees float64
+		want    float64
+	}{
+		"Clockwise":               {h: 200, target: 230, degrees: 10, want: 210},
+		"Counter-clockwise":       {h: 200, target: 50, degrees: 10, want: 190},
+		"Across zero":             {h: 350, target: 50, degrees: 20, want: 10},
+		"Does not overshoot":      {h: 225, target: 230, degrees: 10, want: 230},
+		"Zero degrees is a no-op": {h: 120, target: 50, degrees: 0, want: 120},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := rotateToward(tt.h, tt.target, tt.degrees)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("rotateToward(%v, %v, %v) = %v, want %v", tt.h, tt.target, tt.degrees, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneratePaletteHueShift(t *testing.T) {
+	tests := map[string]struct {
+		opts          Options
+		wantLightWarm bool
+	}{
+		"Natural": {
+			opts:          DefaultPerceptualOptions().WithHueShift(20, HueShiftNatural),
+			wantLightWarm: true,
+		},
+		"Inverse": {
+			opts:          DefaultPerceptualOptions().WithHueShift(20, HueShiftInverse),
+			wantLightWarm: false,
+		},
+		"Negative natural": {
+			opts:          DefaultPerceptualOptions().WithHueShift(-20, HueShiftNatural),
+			wantLightWarm: false,
+		},
+		"Negative inverse": {
+			opts:          DefaultPerceptualOptions().WithHueShift(-20, HueShiftInverse),
+			wantLightWarm: true,
+		},
+	}
+
+	// Red sits between the warm (70) and cool (265) hues, so a warm shift
+	// increases its hue and a cool shift decreases it.
+	const hex = "#DC2626"
+	_, _, baseHue, _ := color.HexToOKLCH(hex)
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePalette(hex, tt.opts)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+
+			_, _, lightHue, _ := color.HexToOKLCH(got.Swatches[1].Hex)
+			_, _, darkHue, _ := color.HexToOKLCH(got.Swatches[9].Hex)
+
+			if (lightHue > baseHue+2) != tt.wantLightWarm {
+				t.Errorf("100 hue %.1f, base hue %.1f, want warm = %v", lightHue, baseHue, tt.wantLightWarm)
+			}
+			if (darkHue < baseHue-2) != tt.wantLightWarm {
+				t.Errorf("900 hue %.1f, base hue %.1f, want cool = %v", darkHue, baseHue, tt.wantLightWarm)
+			}
+		})
+	}
+}
+
+func TestShadeHueShift(t *testing.T) {
+	opts := NewOptions([]Shade{
+		NewShade("light", 80).WithHueShift(120),
+		NewShade("dark", 20),
+	})
+
+	got, err := GeneratePaletteFromHex("#FF0000", opts)
+	if err != nil {
+		t.Fatalf("error = %v", err)
+	}
+
+	if got["light"] != "#99FF99" {
+		t.Errorf("shade light: got %q, want %q", got["light"], "#99FF99")
+	}
+	if got["dark"] != "#660000" {
+		t.Errorf("shade dark: got %q, want %q", got["dark"], "#660000")
+	}
+}
+
+func TestInvalidHueShiftDirection(t *testing.T) {
+	opts := DefaultTailwindOptions().WithHueShift(10, "sideways")
+
+	if _, err := GeneratePalette("#FF0000", opts); err != ErrorInvalidHueShift {
+		t.Errorf("error = %v, want %v", err, ErrorInvalidHueShift)
+	}
+}
+
+func TestNonFiniteHueShift(t *testing.T) {
+	tests := map[string]Options{
+		"NaN curve":            DefaultTailwindOptions().WithHueShift(math.NaN(), HueShiftNatural),
+		"Infinite curve":       DefaultPerceptualOptions().WithHueShift(math.Inf(-1), HueShiftNatural),
+		"NaN shade shift":      NewOptions([]Shade{NewShade("500", 50).WithHueShift(math.NaN())}),
+		"Infinite shade shift": NewOptions([]Shade{NewShade("500", 50).WithHueShift(math.Inf(1))}),
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := GeneratePalette("#FF0000", opts); err != ErrorInvalidHueDegrees {
+				t.Errorf("error = %v, want %v", err, ErrorInvalidHueDegrees)
+			}
+		})
+	}
+}
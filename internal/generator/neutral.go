@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"math"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+const (
+	// DefaultTint is the tint strength of neutral scales unless set with
+	// Options.WithTint, close to Tailwind's gray.
+	DefaultTint = 0.5
+
+	// neutralChroma is the OKLCH chroma of the most tinted neutral shade at
+	// full tint strength, slightly more than Tailwind's slate.
+	neutralChroma = 0.05
+
+	// minTintChroma is the OKLCH chroma below which a base color has no
+	// reliable hue, so its neutral scale stays pure gray.
+	minTintChroma = 0.005
+)
+
+// DefaultNeutralOptions returns the 50 to 950 Tailwind scale in ModeNeutral.
+// Lightness and the chroma curve are modelled on Tailwind CSS v4's slate:
+// chroma builds up through the light shades and stays nearly constant from
+// 400 on.
+func DefaultNeutralOptions() Options {
+	return Options{
+		shades: []Shade{
+			NewShade("50", 98).WithSaturation(0.07),
+			NewShade("100", 97).WithSaturation(0.15),
+			NewShade("200", 93).WithSaturation(0.28),
+			NewShade("300", 87).WithSaturation(0.48),
+			NewShade("400", 70).WithSaturation(0.87),
+			NewShade("500", 55),
+			NewShade("600", 45).WithSaturation(0.93),
+			NewShade("700", 37).WithSaturation(0.96),
+			NewShade("800", 28).WithSaturation(0.89),
+			NewShade("900", 21).WithSaturation(0.91),
+			NewShade("950", 13).WithSaturation(0.91),
+		},
+		mode: ModeNeutral,
+	}
+}
+
+// WithTint returns a copy of the options whose neutral scale is tinted with
+// the given strength, from 0 for pure grays to 1 for grays a little more
+// colorful than Tailwind's slate. It only affects ModeNeutral.
+func (o Options) WithTint(strength float64) Options {
+	o.tint = strength
+	o.hasTint = true
+	return o
+}
+
+// Tint returns the tint strength of neutral scales, DefaultTint unless set.
+func (o Options) Tint() float64 {
+	if !o.hasTint {
+		return DefaultTint
+	}
+	return o.tint
+}
+
+// neutralBase generates in OKLCH like oklchBase, but replaces the base
+// chroma with a fixed low chroma scaled by the tint strength and the shade's
+// saturation multiplier. No shade is the base color, so it cannot be
+// anchored.
+func neutralBase(hex string, opts Options) (baseColor, error) {
+	if opts.anchor != "" {
+		return baseColor{}, ErrorNeutralAnchor
+	}
+	tint := opts.Tint()
+	if tint < 0 || tint > 1 || math.IsNaN(tint) {
+		return baseColor{}, ErrorInvalidTint
+	}
+
+	l, c, h, err := color.HexToOKLCH(hex)
+	if err != nil {
+		return baseColor{}, err
+	}
+	if c < minTintChroma {
+		tint = 0
+	}
+
+	return baseColor{
+		lightness: l * 100,
+		hue:       h,
+		warmHue:   70,
+		coolHue:   265,
+		shade: func(l, h, m float64) (string, error) {
+			sc := math.Min(neutralChroma*tint*m, color.MaxChroma(l, h))
+			return color.OKLCHToHex(l, sc, h)
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+func TestNeutralPalette(t *testing.T) {
+	tests := map[string]struct {
+		hex     string
+		opts    Options
+		want    map[string]string
+		gray    bool
+		wantErr error
+	}{
+		"Default tint": {
+			hex:  "#3B82F6",
+			opts: DefaultNeutralOptions(),
+			want: map[string]string{"50": "#F8F8FA", "500": "#697281", "950": "#030710"},
+		},
+		"Full tint": {
+			hex:  "#3B82F6",
+			opts: DefaultNeutralOptions().WithTint(1),
+			want: map[string]string{"50": "#F7F8FB", "500": "#61728F", "950": "#010619"},
+		},
+		"No tint": {
+			hex:  "#3B82F6",
+			opts: DefaultNeutralOptions().WithTint(0),
+			gray: true,
+		},
+		"Gray base color": {
+			hex:  "#808080",
+			opts: DefaultNeutralOptions().WithTint(1),
+			gray: true,
+		},
+		"Tint too strong": {
+			hex:     "#3B82F6",
+			opts:    DefaultNeutralOptions().WithTint(1.5),
+			wantErr: ErrorInvalidTint,
+		},
+		"Negative tint": {
+			hex:     "#3B82F6",
+			opts:    DefaultNeutralOptions().WithTint(-0.1),
+			wantErr: ErrorInvalidTint,
+		},
+		"Anchored": {
+			hex:     "#3B82F6",
+			opts:    DefaultNeutralOptions().WithAnchor(AnchorAuto),
+			wantErr: ErrorNeutralAnchor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GeneratePalette(tt.hex, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			_, _, baseHue, _ := color.HexToOKLCH(tt.hex)
+			for i, swatch := range got.Swatches {
+				if want, ok := tt.want[swatch.Name]; ok && swatch.Hex != want {
+					t.Errorf("shade %s = %s, want %s", swatch.Name, swatch.Hex, want)
+				}
+
+				l, c, h, _ := color.HexToOKLCH(swatch.Hex)
+				if wantL := float64(tt.opts.shades[i].lightness) / 100; math.Abs(l-wantL) > 0.01 {
+					t.Errorf("shade %s lightness = %.3f, want %.2f", swatch.Name, l, wantL)
+				}
+				if c > neutralChroma*tt.opts.Tint()+0.005 {
+					t.Errorf("shade %s chroma = %.3f, want a neutral", swatch.Name, c)
+				}
+
+				sc, _ := color.ParseHex(swatch.Hex)
+				switch {
+				case tt.gray && (sc.R != sc.G || sc.G != sc.B):
+					t.Errorf("shade %s = %s, want a pure gray", swatch.Name, swatch.Hex)
+				case !tt.gray && c > 0.02 && math.Abs(math.Mod(h-baseHue+540, 360)-180) > 10:
+					t.Errorf("shade %s hue = %.1f, want close to %.1f", swatch.Name, h, baseHue)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorInvalidShade   = errors.New("invalid shade: must be name:lightness[:saturation[:hue-shift]]")
+	ErrorDuplicateShade = errors.New("duplicate shade name")
+)
+
+// ParseShades parses a shade scale definition. Shades are separated by
+// commas or newlines and written as name:lightness, optionally followed by a
+// saturation multiplier and a hue shift in degrees:
+//
+//	50:98:0.95, 100:95, 500:46
+//	950:4:0.75:-5
+//
+// Blank lines and text after a "#" are ignored, so definitions can be kept
+// in commented files.
+func ParseShades(spec string) ([]Shade, error) {
+	var shades []Shade
+	seen := make(map[string]bool)
+
+	for _, line := range strings.Split(spec, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		for _, entry := range strings.Split(line, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+
+			shade, err := parseShade(entry)
+			if err != nil {
+				return nil, err
+			}
+			if seen[shade.name] {
+				return nil, fmt.Errorf("%w: %q", ErrorDuplicateShade, shade.name)
+			}
+			seen[shade.name] = true
+			shades = append(shades, shade)
+		}
+	}
+
+	return shades, nil
+}
+
+func parseShade(entry string) (Shade, error) {
+	fields := strings.Split(entry, ":")
+	if len(fields) < 2 || len(fields) > 4 {
+		return Shade{}, fmt.Errorf("%w, got %q", ErrorInvalidShade, entry)
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	if fields[0] == "" {
+		return Shade{}, fmt.Errorf("%w, got %q", ErrorInvalidShade, entry)
+	}
+
+	lightness, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil || lightness > 100 {
+		return Shade{}, fmt.Errorf("%w in %q", ErrorInvalidLightness, entry)
+	}
+	shade := NewShade(fields[0], uint8(lightness))
+
+	if len(fields) > 2 && fields[2] != "" {
+		m, err := strconv.ParseFloat(fields[2], 64)
+		if err == nil && !finite(m) {
+			return Shade{}, fmt.Errorf("%w, got %q", ErrorInvalidShade, entry)
+		}
+		if err != nil || m < 0 {
+			return Shade{}, fmt.Errorf("%w in %q", ErrorInvalidSaturation, entry)
+		}
+		shade = shade.WithSaturation(m)
+	}
+
+	if len(fields) > 3 && fields[3] != "" {
+		degrees, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil || !finite(degrees) {
+			return Shade{}, fmt.Errorf("%w, got %q", ErrorInvalidShade, entry)
+		}
+		shade = shade.WithHueShift(degrees)
+	}
+
+	return shade, nil
+}
+
+func finite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+// FormatShades writes a shade scale in the format read by ParseShades, one
+// shade per line. Saturation multipliers of 1 and zero hue shifts are left
+// out.
+func FormatShades(shades []Shade) string {
+	var b strings.Builder
+	for _, shade := range shades {
+		fmt.Fprintf(&b, "%s:%d", shade.name, shade.lightness)
+		if shade.Saturation() != 1 || shade.hueShift != 0 {
+			b.WriteByte(':')
+			if shade.Saturation() != 1 {
+				b.WriteString(strconv.FormatFloat(shade.Saturation(), 'f', -1, 64))
+			}
+		}
+		if shade.hueShift != 0 {
+			b.WriteString(":" + strconv.FormatFloat(shade.hueShift, 'f', -1, 64))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
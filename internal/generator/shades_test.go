@@ -0,0 +1,135 @@
+package generator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseShades(t *testing.T) {
+	tests := map[string]struct {
+		spec    string
+		want    []Shade
+		wantErr error
+	}{
+		"Comma separated": {
+			spec: "25:99,50:97, 500:46",
+			want: []Shade{
+				NewShade("25", 99),
+				NewShade("50", 97),
+				NewShade("500", 46),
+			},
+		},
+		"Saturation and hue shift": {
+			spec: "950:4:0.75:-5, 900:7::3",
+			want: []Shade{
+				NewShade("950", 4).WithSaturation(0.75).WithHueShift(-5),
+				NewShade("900", 7).WithHueShift(3),
+			},
+		},
+		"File with comments": {
+			spec: "# light shades\nlight:80 # tint\n\n\ndark:20\n",
+			want: []Shade{
+				NewShade("light", 80),
+				NewShade("dark", 20),
+			},
+		},
+		"Empty": {
+			spec: "  \n# nothing here\n",
+			want: nil,
+		},
+		"Missing lightness": {
+			spec:    "50",
+			wantErr: ErrorInvalidShade,
+		},
+		"Missing name": {
+			spec:    ":50",
+			wantErr: ErrorInvalidShade,
+		},
+		"Too many fields": {
+			spec:    "50:98:1:0:1",
+			wantErr: ErrorInvalidShade,
+		},
+		"Lightness out of range": {
+			spec:    "50:101",
+			wantErr: ErrorInvalidLightness,
+		},
+		"Lightness not a number": {
+			spec:    "50:light",
+			wantErr: ErrorInvalidLightness,
+		},
+		"Negative saturation": {
+			spec:    "50:98:-1",
+			wantErr: ErrorInvalidSaturation,
+		},
+		"Invalid hue shift": {
+			spec:    "50:98:1:warm",
+			wantErr: ErrorInvalidShade,
+		},
+		"NaN saturation": {
+			spec:    "50:98:NaN",
+			wantErr: ErrorInvalidShade,
+		},
+		"Infinite saturation": {
+			spec:    "50:98:+Inf",
+			wantErr: ErrorInvalidShade,
+		},
+		"Infinite hue shift": {
+			spec:    "500:46::Inf",
+			wantErr: ErrorInvalidShade,
+		},
+		"NaN hue shift": {
+			spec:    "500:46:1:nan",
+			wantErr: ErrorInvalidShade,
+		},
+		"Duplicate shade": {
+			spec:    "50:98,50:97",
+			wantErr: ErrorDuplicateShade,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseShades(tt.spec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d shades, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("shade %d: got %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFormatShades(t *testing.T) {
+	shades := []Shade{
+		NewShade("50", 98),
+		NewShade("500", 46).WithSaturation(0.95),
+		NewShade("900", 7).WithHueShift(-3.5),
+		NewShade("950", 4).WithSaturation(0.75).WithHueShift(5),
+	}
+
+	got := FormatShades(shades)
+	want := "50:98\n500:46:0.95\n900:7::-3.5\n950:4:0.75:5\n"
+	if got != want {
+		t.Errorf("FormatShades() = %q, want %q", got, want)
+	}
+
+	parsed, err := ParseShades(got)
+	if err != nil {
+		t.Fatalf("ParseShades() error = %v", err)
+	}
+	for i := range shades {
+		if parsed[i] != shades[i] {
+			t.Errorf("shade %d: got %+v after a round trip, want %+v", i, parsed[i], shades[i])
+		}
+	}
+}
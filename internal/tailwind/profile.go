@@ -0,0 +1,117 @@
+package tailwind
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+var (
+	ErrorUnknownPalette = errors.New("unknown Tailwind palette: must be a default color name such as 'blue', or 'auto'")
+)
+
+// ProfileAuto selects the default palette whose hue is nearest to the base
+// color as the profile. See NearestHue.
+const ProfileAuto = "auto"
+
+// grayChroma is the OKLCH chroma below which a palette (measured at its 500
+// shade) or a color counts as gray.
+const grayChroma = 0.05
+
+// Palette returns the shades of the named default palette of a Tailwind
+// version, from 50 to 950.
+func Palette(version Version, name string) ([]Color, error) {
+	colors, err := Colors(version)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	var palette []Color
+	for _, c := range colors {
+		if c.Name == name {
+			palette = append(palette, c)
+		}
+	}
+	if palette == nil {
+		return nil, ErrorUnknownPalette
+	}
+	return palette, nil
+}
+
+// NearestHue returns the name of the default palette of a Tailwind version
+// that a color belongs with: the colorful palette nearest in OKLCH hue, or
+// for grayish colors the gray palette nearest in tint, such as slate for a
+// bluish gray.
+func NearestHue(version Version, hex string) (string, error) {
+	_, a, b, err := color.HexToOKLab(hex)
+	if err != nil {
+		return "", err
+	}
+	gray := math.Hypot(a, b) < grayChroma
+	hue := hueDegrees(a, b)
+
+	best, bestDistance := "", math.Inf(1)
+	for _, name := range Names {
+		c, _, err := Lookup(version, name+"-500")
+		if err != nil {
+			return "", err
+		}
+		_, pa, pb, err := color.HexToOKLab(c.Hex)
+		if err != nil {
+			return "", err
+		}
+		if (math.Hypot(pa, pb) < grayChroma) != gray {
+			continue
+		}
+
+		var distance float64
+		if gray {
+			distance = math.Hypot(a-pa, b-pb)
+		} else {
+			distance = math.Abs(math.Mod(hueDegrees(pa, pb)-hue+540, 360) - 180)
+		}
+		if distance < bestDistance {
+			best, bestDistance = name, distance
+		}
+	}
+	return best, nil
+}
+
+// Profile returns the shade scale of the named default palette of a
+// Tailwind version, or with ProfileAuto of the palette nearest in hue to
+// hex, as fitted by generator.FitOptions in the given mode. Generating a
+// color with it gives a palette with the lightness, saturation and hue drift
+// of the Tailwind one; in generator.ModeNeutral, the chroma curve is scaled
+// by the tint strength instead. hex is only used with ProfileAuto.
+func Profile(version Version, name, hex string, mode generator.Mode) ([]generator.Shade, error) {
+	if strings.EqualFold(strings.TrimSpace(name), ProfileAuto) {
+		var err error
+		name, err = NearestHue(version, hex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	palette, err := Palette(version, name)
+	if err != nil {
+		return nil, err
+	}
+
+	swatches := make([]generator.Swatch, len(palette))
+	for i, c := range palette {
+		swatches[i] = generator.Swatch{Name: c.Shade, Hex: c.Hex}
+	}
+	opts, _, err := generator.FitOptions(swatches, mode)
+	if err != nil {
+		return nil, err
+	}
+	return opts.Shades(), nil
+}
+
+func hueDegrees(a, b float64) float64 {
+	return math.Mod(math.Atan2(b, a)*180/math.Pi+360, 360)
+}
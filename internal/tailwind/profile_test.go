@@ -0,0 +1,112 @@
+package tailwind
+
+import (
+	"math"
+	"testing"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+func TestNearestHue(t *testing.T) {
+	tests := []struct {
+		version Version
+		hex     string
+		want    string
+	}{
+		{version: V4, hex: "#3B82F6", want: "blue"},
+		{version: V4, hex: "#E11D48", want: "rose"},
+		{version: V3, hex: "#10B981", want: "emerald"},
+		{version: V4, hex: "#808080", want: "neutral"},
+		{version: V3, hex: "#64748B", want: "slate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.version)+" "+tt.hex, func(t *testing.T) {
+			got, err := NearestHue(tt.version, tt.hex)
+			if err != nil {
+				t.Fatalf("NearestHue() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NearestHue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   Version
+		profile   string
+		hex       string
+		mode      generator.Mode
+		reproduce string
+		wantErr   error
+	}{
+		{name: "Named v4 profile", version: V4, profile: "blue", mode: generator.ModeOKLCH, reproduce: "blue"},
+		{name: "Named v3 profile in HSL", version: V3, profile: "Orange", mode: generator.ModeHSL, reproduce: "orange"},
+		{name: "Auto profile", version: V4, profile: ProfileAuto, hex: "#D946EF", mode: generator.ModeOKLCH, reproduce: "fuchsia"},
+		{name: "Colorful profile in neutral mode", version: V4, profile: "blue", mode: generator.ModeNeutral, reproduce: "blue"},
+		{name: "Gray profile in neutral mode", version: V3, profile: "slate", mode: generator.ModeNeutral, reproduce: "slate"},
+		{name: "Unknown profile", version: V4, profile: "brand", mode: generator.ModeOKLCH, wantErr: ErrorUnknownPalette},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shades, err := Profile(tt.version, tt.profile, tt.hex, tt.mode)
+			if err != tt.wantErr {
+				t.Fatalf("Profile() error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(shades) != len(Shades) {
+				t.Fatalf("Profile() returned %d shades, want %d", len(shades), len(Shades))
+			}
+
+			// Every shade should take the lightness of the reference shade
+			// and keep the hue of the base color, apart from its hue drift.
+			want, err := Palette(tt.version, tt.reproduce)
+			if err != nil {
+				t.Fatal(err)
+			}
+			base := "#0EA5E9"
+			palette, err := generator.GeneratePalette(base, generator.NewOptions(shades).WithMode(tt.mode))
+			if err != nil {
+				t.Fatalf("GeneratePalette() error = %v", err)
+			}
+			_, _, baseHue, _ := color.HexToOKLCH(base)
+			for i, swatch := range palette.Swatches {
+				got, wantL := lightness(t, swatch.Hex, tt.mode), lightness(t, want[i].Hex, tt.mode)
+				if math.Abs(got-wantL) > 0.01 {
+					t.Errorf("%s lightness = %.3f, want %.3f like %s-%s", swatch.Name, got, wantL, tt.reproduce, want[i].Shade)
+				}
+				_, c, h, _ := color.HexToOKLCH(swatch.Hex)
+				if c > grayChroma && math.Abs(math.Mod(h-baseHue+540, 360)-180) > 30 {
+					t.Errorf("%s hue = %.1f, want close to %.1f", swatch.Name, h, baseHue)
+				}
+				if tt.mode == generator.ModeNeutral && c > grayChroma {
+					t.Errorf("%s chroma = %.3f, want a neutral", swatch.Name, c)
+				}
+			}
+		})
+	}
+}
+
+// lightness returns the lightness of hex on the scale of mode.
+func lightness(t *testing.T, hex string, mode generator.Mode) float64 {
+	t.Helper()
+	if mode == generator.ModeHSL {
+		_, _, l, err := color.HexToHSL(hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return l
+	}
+	l, _, _, err := color.HexToOKLCH(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
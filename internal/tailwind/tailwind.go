@@ -0,0 +1,206 @@
+// Package tailwind embeds the default color palettes of Tailwind CSS v3 and
+// v4 and finds the Tailwind colors closest to a given color.
+package tailwind
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/claytonchew/tailwindcss-palette-go/internal/color"
+)
+
+// Version is a major version of Tailwind CSS.
+type Version string
+
+const (
+	// V3 is Tailwind CSS v3, whose default colors are sRGB hex values.
+	V3 Version = "v3"
+	// V4 is Tailwind CSS v4, whose default colors are OKLCH values. Some
+	// are outside the sRGB gamut; their Hex is clipped.
+	V4 Version = "v4"
+)
+
+var (
+	ErrorUnknownVersion = errors.New("unknown Tailwind CSS version: must be one of 'v3' or 'v4'")
+)
+
+// Names lists the default Tailwind palettes, in the order of the Tailwind
+// documentation.
+var Names = []string{
+	"slate", "gray", "zinc", "neutral", "stone",
+	"red", "orange", "amber", "yellow", "lime", "green", "emerald", "teal",
+	"cyan", "sky", "blue", "indigo", "violet", "purple", "fuchsia", "pink", "rose",
+}
+
+// Shades lists the shades of every default Tailwind palette.
+var Shades = []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "950"}
+
+// Color is one shade of a default Tailwind palette. Value is the color as
+// Tailwind defines it, Hex its sRGB form.
+type Color struct {
+	Name  string
+	Shade string
+	Value string
+	Hex   string
+}
+
+// Ref returns the color's Tailwind name, such as "blue-500".
+func (c Color) Ref() string {
+	return c.Name + "-" + c.Shade
+}
+
+// Match is a Tailwind color and its CIEDE2000 difference from another
+// color.
+type Match struct {
+	Color
+	DeltaE float64
+}
+
+var (
+	//go:embed data/v3.json
+	v3Data []byte
+	//go:embed data/v4.css
+	v4Data []byte
+)
+
+var palettes = sync.OnceValue(func() map[Version][]Color {
+	return map[Version][]Color{
+		V3: mustLoad(loadV3(v3Data)),
+		V4: mustLoad(loadV4(v4Data)),
+	}
+})
+
+// Colors returns every default color of a Tailwind version, palette by
+// palette in the order of Names and shade by shade in the order of Shades.
+func Colors(version Version) ([]Color, error) {
+	colors, ok := palettes()[version]
+	if !ok {
+		return nil, ErrorUnknownVersion
+	}
+	return slices.Clone(colors), nil
+}
+
+// Lookup returns the default color of a Tailwind version for a reference
+// such as "blue-500".
+func Lookup(version Version, ref string) (Color, bool, error) {
+	colors, ok := palettes()[version]
+	if !ok {
+		return Color{}, false, ErrorUnknownVersion
+	}
+
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	for _, c := range colors {
+		if c.Ref() == ref {
+			return c, true, nil
+		}
+	}
+	return Color{}, false, nil
+}
+
+// Nearest returns the n default colors of a Tailwind version closest to hex
+// by CIEDE2000, closest first.
+func Nearest(version Version, hex string, n int) ([]Match, error) {
+	colors, ok := palettes()[version]
+	if !ok {
+		return nil, ErrorUnknownVersion
+	}
+
+	target, err := color.ParseHex(hex)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, len(colors))
+	for i, c := range colors {
+		tc, err := color.ParseHex(c.Hex)
+		if err != nil {
+			return nil, err
+		}
+		matches[i] = Match{Color: c, DeltaE: target.DeltaE2000(tc)}
+	}
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		switch {
+		case a.DeltaE < b.DeltaE:
+			return -1
+		case a.DeltaE > b.DeltaE:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return matches[:min(max(n, 0), len(matches))], nil
+}
+
+// loadV3 reads palettes from a JSON object of palettes keyed by name, each
+// an object of hex values keyed by shade, as in Tailwind v3's colors.js.
+func loadV3(data []byte) ([]Color, error) {
+	var raw map[string]map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var colors []Color
+	for _, name := range Names {
+		for _, shade := range Shades {
+			value, ok := raw[name][shade]
+			if !ok {
+				return nil, fmt.Errorf("missing %s-%s", name, shade)
+			}
+			c, err := color.ParseHex(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s-%s: %w", name, shade, err)
+			}
+			colors = append(colors, Color{Name: name, Shade: shade, Value: value, Hex: c.Hex()})
+		}
+	}
+	return colors, nil
+}
+
+// loadV4 reads palettes from "--color-<name>-<shade>: <value>;" custom
+// properties, as in Tailwind v4's theme.css.
+func loadV4(data []byte) ([]Color, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		property, value, ok := strings.Cut(line, ":")
+		if !ok || !strings.HasPrefix(property, "--color-") {
+			continue
+		}
+		values[strings.TrimPrefix(property, "--color-")] = strings.TrimSuffix(strings.TrimSpace(value), ";")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var colors []Color
+	for _, name := range Names {
+		for _, shade := range Shades {
+			value, ok := values[name+"-"+shade]
+			if !ok {
+				return nil, fmt.Errorf("missing %s-%s", name, shade)
+			}
+			c, err := color.Parse(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s-%s: %w", name, shade, err)
+			}
+			colors = append(colors, Color{Name: name, Shade: shade, Value: value, Hex: c.Hex()})
+		}
+	}
+	return colors, nil
+}
+
+func mustLoad(colors []Color, err error) []Color {
+	if err != nil {
+		panic("tailwind: invalid embedded palette: " + err.Error())
+	}
+	return colors
+}
@@ -0,0 +1,108 @@
+package tailwind
+
+import (
+	"testing"
+)
+
+func TestColors(t *testing.T) {
+	for _, version := range []Version{V3, V4} {
+		colors, err := Colors(version)
+		if err != nil {
+			t.Fatalf("Colors(%s) error = %v", version, err)
+		}
+		if want := len(Names) * len(Shades); len(colors) != want {
+			t.Errorf("Colors(%s) returned %d colors, want %d", version, len(colors), want)
+		}
+		if colors[0].Ref() != "slate-50" || colors[len(colors)-1].Ref() != "rose-950" {
+			t.Errorf("Colors(%s) = %s ... %s, want slate-50 ... rose-950", version, colors[0].Ref(), colors[len(colors)-1].Ref())
+		}
+	}
+
+	if _, err := Colors("v2"); err != ErrorUnknownVersion {
+		t.Errorf("Colors(v2) error = %v, want %v", err, ErrorUnknownVersion)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		version   Version
+		ref       string
+		wantValue string
+		wantHex   string
+		wantFound bool
+	}{
+		{version: V3, ref: "blue-500", wantValue: "#3b82f6", wantHex: "#3B82F6", wantFound: true},
+		{version: V3, ref: " Slate-950 ", wantValue: "#020617", wantHex: "#020617", wantFound: true},
+		{version: V4, ref: "blue-500", wantValue: "oklch(62.3% 0.214 259.815)", wantHex: "#2B7FFF", wantFound: true},
+		{version: V4, ref: "red-500", wantValue: "oklch(63.7% 0.237 25.331)", wantHex: "#FB2C36", wantFound: true},
+		{version: V4, ref: "neutral-500", wantValue: "oklch(55.6% 0 0)", wantHex: "#737373", wantFound: true},
+		{version: V4, ref: "blue-550"},
+		{version: V4, ref: "brand-500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.version)+" "+tt.ref, func(t *testing.T) {
+			got, found, err := Lookup(tt.version, tt.ref)
+			if err != nil {
+				t.Fatalf("Lookup() error = %v", err)
+			}
+			if found != tt.wantFound {
+				t.Fatalf("Lookup() found = %v, want %v", found, tt.wantFound)
+			}
+			if got.Value != tt.wantValue || got.Hex != tt.wantHex {
+				t.Errorf("Lookup() = %s (%s), want %s (%s)", got.Value, got.Hex, tt.wantValue, tt.wantHex)
+			}
+		})
+	}
+}
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    Version
+		hex        string
+		n          int
+		want       []string
+		wantDeltaE float64
+	}{
+		{name: "Exact v3 match", version: V3, hex: "#3B82F6", n: 3, want: []string{"blue-500", "sky-600", "blue-400"}, wantDeltaE: 0},
+		{name: "Closest v4 match", version: V4, hex: "#3B82F6", n: 1, want: []string{"blue-500"}, wantDeltaE: 1.13},
+		{name: "Gray", version: V3, hex: "#737373", n: 1, want: []string{"neutral-500"}, wantDeltaE: 0},
+		{name: "More than available", version: V3, hex: "#000000", n: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Nearest(tt.version, tt.hex, tt.n)
+			if err != nil {
+				t.Fatalf("Nearest() error = %v", err)
+			}
+
+			if tt.want == nil {
+				if len(got) != len(Names)*len(Shades) {
+					t.Errorf("Nearest() returned %d matches, want all of them", len(got))
+				}
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Nearest() returned %d matches, want %d", len(got), len(tt.want))
+			}
+			for i, ref := range tt.want {
+				if got[i].Ref() != ref {
+					t.Errorf("match %d = %s, want %s", i, got[i].Ref(), ref)
+				}
+				if i > 0 && got[i].DeltaE < got[i-1].DeltaE {
+					t.Errorf("match %d is closer than match %d", i, i-1)
+				}
+			}
+			if d := got[0].DeltaE - tt.wantDeltaE; d > 0.01 || d < -0.01 {
+				t.Errorf("closest ΔE = %.2f, want %v", got[0].DeltaE, tt.wantDeltaE)
+			}
+		})
+	}
+
+	if _, err := Nearest(V4, "#ZZ0000", 1); err == nil {
+		t.Error("Nearest() with an invalid hex: expected an error")
+	}
+}
@@ -0,0 +1,37 @@
+package palette_test
+
+import (
+	"fmt"
+
+	"github.com/claytonchew/tailwindcss-palette-go/palette"
+)
+
+func ExampleGenerate() {
+	p, err := palette.Generate("#3B82F6", palette.DefaultTailwindOptions())
+	if err != nil {
+		panic(err)
+	}
+
+	for _, swatch := range p.Swatches[:3] {
+		fmt.Println(swatch.Name, swatch.Hex)
+	}
+	// Output:
+	// 50 #F5F8FE
+	// 100 #E7EFFD
+	// 200 #CEE0FC
+}
+
+func ExampleNewOptions() {
+	opts := palette.NewOptions([]palette.Shade{
+		palette.NewShade("light", 80),
+		palette.NewShade("dark", 20),
+	})
+
+	p, err := palette.Generate("#0000FF", opts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(p.Map())
+	// Output: map[dark:#000066 light:#9999FF]
+}
@@ -0,0 +1,178 @@
+// Package palette generates Tailwind CSS-like color palettes from a base
+// color.
+//
+// A palette is generated from a base color and a set of Options, which
+// describe the shade scale (for example Tailwind's 50 to 950) and the color
+// space the shades are computed in:
+//
+//	p, err := palette.Generate("#3B82F6", palette.DefaultTailwindOptions())
+//	if err != nil {
+//		return err
+//	}
+//	for _, swatch := range p.Swatches {
+//		fmt.Println(swatch.Name, swatch.Hex)
+//	}
+package palette
+
+import (
+	"github.com/claytonchew/tailwindcss-palette-go/internal/generator"
+)
+
+// Shade is a named step of a palette scale together with its target
+// lightness in percent, a saturation multiplier and an optional fixed hue
+// rotation. In ModeHSL the lightness is HSL lightness, in ModeOKLCH and
+// ModeNeutral it is perceptual OKLab lightness.
+type Shade = generator.Shade
+
+// Options describes the shade scale and the mode a palette is generated
+// with. Options are immutable; the With* methods return modified copies.
+type Options = generator.Options
+
+// Mode selects the color space a palette is generated in.
+type Mode = generator.Mode
+
+// HueShiftDirection selects which way Options.WithHueShift rotates hues.
+type HueShiftDirection = generator.HueShiftDirection
+
+// ContrastMethod selects how Options.WithForeground measures contrast.
+type ContrastMethod = generator.ContrastMethod
+
+// Swatch is a single generated shade, with the text color to use on it.
+type Swatch = generator.Swatch
+
+// Palette is the ordered list of swatches generated from a base color.
+type Palette = generator.Palette
+
+const (
+	// ModeHSL keeps the base hue and saturation and sets HSL lightness per
+	// shade.
+	ModeHSL = generator.ModeHSL
+	// ModeOKLCH keeps the base hue, sets perceptual lightness per shade and
+	// tapers chroma toward both ends of the scale.
+	ModeOKLCH = generator.ModeOKLCH
+	// ModeNeutral keeps only the base hue and generates a low-chroma gray
+	// scale tinted toward it. See Options.WithTint.
+	ModeNeutral = generator.ModeNeutral
+)
+
+// DefaultTint is the tint strength of neutral scales unless set with
+// Options.WithTint.
+const DefaultTint = generator.DefaultTint
+
+const (
+	// HueShiftNatural rotates light shades toward warm hues and dark shades
+	// toward cool hues.
+	HueShiftNatural = generator.HueShiftNatural
+	// HueShiftInverse rotates light shades toward cool hues and dark shades
+	// toward warm hues.
+	HueShiftInverse = generator.HueShiftInverse
+)
+
+const (
+	// ContrastWCAG measures the WCAG 2.x contrast ratio.
+	ContrastWCAG = generator.ContrastWCAG
+	// ContrastAPCA measures the absolute APCA Lc of text on the shade.
+	ContrastAPCA = generator.ContrastAPCA
+)
+
+const (
+	// DefaultForegroundTarget is the WCAG ratio foregrounds target by
+	// default.
+	DefaultForegroundTarget = generator.DefaultForegroundTarget
+	// DefaultAPCAForegroundTarget is the APCA Lc recommended for body text.
+	DefaultAPCAForegroundTarget = generator.DefaultAPCAForegroundTarget
+)
+
+// AnchorAuto anchors the base color at the shade whose lightness is closest
+// to its own. See Options.WithAnchor.
+const AnchorAuto = generator.AnchorAuto
+
+var (
+	// ErrorInvalidLightness is returned for shades with a lightness above 100.
+	ErrorInvalidLightness = generator.ErrorInvalidLightness
+	// ErrorInvalidMode is returned for unknown generation modes.
+	ErrorInvalidMode = generator.ErrorInvalidMode
+	// ErrorUnknownAnchor is returned when the anchor names no shade.
+	ErrorUnknownAnchor = generator.ErrorUnknownAnchor
+	// ErrorInvalidSaturation is returned for negative saturation multipliers.
+	ErrorInvalidSaturation = generator.ErrorInvalidSaturation
+	// ErrorInvalidShade is returned by ParseShades for malformed entries.
+	ErrorInvalidShade = generator.ErrorInvalidShade
+	// ErrorDuplicateShade is returned by ParseShades when a name repeats.
+	ErrorDuplicateShade = generator.ErrorDuplicateShade
+	// ErrorInvalidHueShift is returned for unknown hue shift directions.
+	ErrorInvalidHueShift = generator.ErrorInvalidHueShift
+	// ErrorInvalidContrastMethod is returned for unknown foreground contrast
+	// methods.
+	ErrorInvalidContrastMethod = generator.ErrorInvalidContrastMethod
+	// ErrorInvalidContrastTarget is returned for negative foreground contrast
+	// targets.
+	ErrorInvalidContrastTarget = generator.ErrorInvalidContrastTarget
+	// ErrorNothingToFit is returned by FitOptions for a palette without
+	// shades.
+	ErrorNothingToFit = generator.ErrorNothingToFit
+	// ErrorInvalidTint is returned for tint strengths outside [0, 1].
+	ErrorInvalidTint = generator.ErrorInvalidTint
+	// ErrorInvalidHueDegrees is returned for hue shifts that are not finite.
+	ErrorInvalidHueDegrees = generator.ErrorInvalidHueDegrees
+	// ErrorNeutralAnchor is returned for anchored options in ModeNeutral.
+	ErrorNeutralAnchor = generator.ErrorNeutralAnchor
+)
+
+// NewShade returns a shade with the given name and lightness in percent.
+func NewShade(name string, lightness uint8) Shade {
+	return generator.NewShade(name, lightness)
+}
+
+// NewOptions returns options that generate the given shades in ModeHSL.
+func NewOptions(shades []Shade) Options {
+	return generator.NewOptions(shades)
+}
+
+// DefaultTailwindOptions returns the 50 to 950 Tailwind scale in ModeHSL.
+func DefaultTailwindOptions() Options {
+	return generator.DefaultTailwindOptions()
+}
+
+// DefaultPerceptualOptions returns the 50 to 950 Tailwind scale in
+// ModeOKLCH, with lightness modelled on the Tailwind CSS v4 palette.
+func DefaultPerceptualOptions() Options {
+	return generator.DefaultPerceptualOptions()
+}
+
+// DefaultNeutralOptions returns the 50 to 950 Tailwind scale in
+// ModeNeutral, with lightness and chroma modelled on Tailwind CSS v4's
+// slate.
+func DefaultNeutralOptions() Options {
+	return generator.DefaultNeutralOptions()
+}
+
+// ParseShades parses a shade scale such as "25:99, 50:97:0.95, 950:4:0.75:-5".
+// Each shade is name:lightness, optionally followed by a saturation
+// multiplier and a hue shift in degrees. Shades are separated by commas or
+// newlines, and "#" starts a comment.
+func ParseShades(spec string) ([]Shade, error) {
+	return generator.ParseShades(spec)
+}
+
+// FormatShades writes a shade scale in the format read by ParseShades, one
+// shade per line.
+func FormatShades(shades []Shade) string {
+	return generator.FormatShades(shades)
+}
+
+// FitOptions estimates the options that reproduce an existing palette in
+// the given mode, and returns them with the palette's most colorful shade.
+// Generating from that shade's color reproduces the palette; generating from
+// other colors gives palettes of the same shape. In ModeNeutral the options
+// also carry the tint strength of the palette, capped at 1.
+func FitOptions(swatches []Swatch, mode Mode) (Options, Swatch, error) {
+	return generator.FitOptions(swatches, mode)
+}
+
+// Generate generates a palette from a base color. The base color may be any
+// CSS color accepted by colorx.Parse, such as "#3B82F6", "rgb(59 130 246)"
+// or "cornflowerblue".
+func Generate(color string, opts Options) (Palette, error) {
+	return generator.GeneratePalette(color, opts)
+}
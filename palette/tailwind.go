@@ -0,0 +1,74 @@
+package palette
+
+import (
+	"github.com/claytonchew/tailwindcss-palette-go/internal/tailwind"
+)
+
+// TailwindVersion is a major version of Tailwind CSS.
+type TailwindVersion = tailwind.Version
+
+// TailwindColor is one shade of a default Tailwind palette, such as
+// blue-500.
+type TailwindColor = tailwind.Color
+
+// TailwindMatch is a default Tailwind color and its CIEDE2000 difference
+// from another color.
+type TailwindMatch = tailwind.Match
+
+const (
+	// TailwindV3 is Tailwind CSS v3, whose default colors are hex values.
+	TailwindV3 = tailwind.V3
+	// TailwindV4 is Tailwind CSS v4, whose default colors are OKLCH values.
+	TailwindV4 = tailwind.V4
+)
+
+// TailwindProfileAuto selects the default palette nearest in hue to the
+// base color as the profile. See TailwindProfile.
+const TailwindProfileAuto = tailwind.ProfileAuto
+
+var (
+	// ErrorUnknownTailwindVersion is returned for Tailwind versions other
+	// than TailwindV3 and TailwindV4.
+	ErrorUnknownTailwindVersion = tailwind.ErrorUnknownVersion
+	// ErrorUnknownTailwindPalette is returned for palette names that are
+	// not default Tailwind palettes.
+	ErrorUnknownTailwindPalette = tailwind.ErrorUnknownPalette
+)
+
+// TailwindColors returns every default color of a Tailwind version, from
+// slate-50 to rose-950.
+func TailwindColors(version TailwindVersion) ([]TailwindColor, error) {
+	return tailwind.Colors(version)
+}
+
+// LookupTailwind returns the default color of a Tailwind version for a
+// reference such as "blue-500", and whether it exists.
+func LookupTailwind(version TailwindVersion, ref string) (TailwindColor, bool, error) {
+	return tailwind.Lookup(version, ref)
+}
+
+// NearestTailwind returns the n default colors of a Tailwind version
+// closest to a hex color by CIEDE2000, closest first.
+func NearestTailwind(version TailwindVersion, hex string, n int) ([]TailwindMatch, error) {
+	return tailwind.Nearest(version, hex, n)
+}
+
+// TailwindPalette returns the shades of a default Tailwind palette, such as
+// "blue", from 50 to 950.
+func TailwindPalette(version TailwindVersion, name string) ([]TailwindColor, error) {
+	return tailwind.Palette(version, name)
+}
+
+// NearestTailwindHue returns the default Tailwind palette a color belongs
+// with: the colorful palette nearest in OKLCH hue, or the gray palette
+// nearest in tint for grayish colors.
+func NearestTailwindHue(version TailwindVersion, hex string) (string, error) {
+	return tailwind.NearestHue(version, hex)
+}
+
+// TailwindProfile returns the shade scale of a default Tailwind palette
+// fitted in the given mode, for use with Options.WithShades. With
+// TailwindProfileAuto the palette nearest in hue to hex is used.
+func TailwindProfile(version TailwindVersion, name, hex string, mode Mode) ([]Shade, error) {
+	return tailwind.Profile(version, name, hex, mode)
+}